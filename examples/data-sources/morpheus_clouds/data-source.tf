@@ -0,0 +1,4 @@
+data "morpheus_clouds" "vmware_clouds" {
+  name_regex = "^prod-"
+  type_code  = "vmware"
+}
\ No newline at end of file
@@ -0,0 +1,3 @@
+data "morpheus_groups" "cloud_groups" {
+  cloud_id = 1
+}
\ No newline at end of file
@@ -0,0 +1,3 @@
+data "morpheus_instance_types" "database_instance_types" {
+  category = "sql"
+}
\ No newline at end of file
@@ -0,0 +1,5 @@
+data "morpheus_instances" "web_instances" {
+  name_regex = "^web"
+  cloud_id   = 1
+  labels     = ["production"]
+}
\ No newline at end of file
@@ -0,0 +1,9 @@
+data "morpheus_networks" "dmz_networks" {
+  cloud_id = 1
+  labels   = ["dmz"]
+}
+
+resource "morpheus_network_domain" "dmz" {
+  for_each = toset([for network in data.morpheus_networks.dmz_networks.networks : network.name])
+  name     = "${each.value}.example.com"
+}
\ No newline at end of file
@@ -0,0 +1,21 @@
+data "morpheus_option_list" "tf_example_option_list" {
+  name = "Cloud Networks"
+}
+
+data "morpheus_cloud" "tf_example_cloud" {
+  name = "tf_example_vsphere_cloud"
+}
+
+data "morpheus_option_list_preview" "tf_example_option_list_preview" {
+  option_list_id = data.morpheus_option_list.tf_example_option_list.id
+  input = {
+    zoneId = data.morpheus_cloud.tf_example_cloud.id
+  }
+}
+
+check "tf_example_option_list_networks" {
+  assert {
+    condition     = length(data.morpheus_option_list_preview.tf_example_option_list_preview.options) > 0
+    error_message = "The option list did not return any networks for the cloud"
+  }
+}
\ No newline at end of file
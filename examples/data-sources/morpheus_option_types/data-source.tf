@@ -0,0 +1,4 @@
+data "morpheus_option_types" "select_option_types" {
+  name_regex = "^tf"
+  type_code  = "select"
+}
\ No newline at end of file
@@ -0,0 +1,4 @@
+data "morpheus_plans" "vmware_plans" {
+  name_regex = "^Small"
+  type_code  = "vmware"
+}
\ No newline at end of file
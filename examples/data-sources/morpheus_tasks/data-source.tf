@@ -0,0 +1,4 @@
+data "morpheus_tasks" "script_tasks" {
+  type_code = "script"
+  labels    = ["bootstrap"]
+}
\ No newline at end of file
@@ -0,0 +1,3 @@
+data "morpheus_workflows" "provisioning_workflows" {
+  type_code = "provision"
+}
\ No newline at end of file
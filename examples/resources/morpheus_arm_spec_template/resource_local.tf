@@ -3,83 +3,38 @@ resource "morpheus_arm_spec_template" "tfexample_arm_spec_template_local" {
   source_type  = "local"
   spec_content = <<TFEOF
 {
-  "type": "Microsoft.Storage/storageAccounts",
-  "apiVersion": "2019-04-01",
-  "name": "string",
-  "location": "string",
-  "tags": {
-    "tagName1": "tagValue1",
-    "tagName2": "tagValue2"
-  },
-  "sku": {
-    "name": "string",
-    "restrictions": [
-      {
-        "reasonCode": "string"
-      }
-    ]
-  },
-  "kind": "string",
-  "identity": {
-    "type": "SystemAssigned"
+  "$schema": "https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {
+    "storageAccountName": {
+      "type": "string"
+    },
+    "location": {
+      "type": "string",
+      "defaultValue": "[resourceGroup().location]"
+    }
   },
-  "properties": {
-    "accessTier": "string",
-    "allowBlobPublicAccess": "bool",
-    "allowSharedKeyAccess": "bool",
-    "azureFilesIdentityBasedAuthentication": {
-      "activeDirectoryProperties": {
-        "azureStorageSid": "string",
-        "domainGuid": "string",
-        "domainName": "string",
-        "domainSid": "string",
-        "forestName": "string",
-        "netBiosDomainName": "string"
+  "resources": [
+    {
+      "type": "Microsoft.Storage/storageAccounts",
+      "apiVersion": "2019-04-01",
+      "name": "[parameters('storageAccountName')]",
+      "location": "[parameters('location')]",
+      "tags": {
+        "tagName1": "tagValue1",
+        "tagName2": "tagValue2"
       },
-      "directoryServiceOptions": "string"
-    },
-    "customDomain": {
-      "name": "string",
-      "useSubDomainName": "bool"
-    },
-    "encryption": {
-      "keySource": "string",
-      "keyvaultproperties": {
-        "keyname": "string",
-        "keyvaulturi": "string",
-        "keyversion": "string"
+      "sku": {
+        "name": "Standard_LRS"
       },
-      "services": {
-        "blob": {
-          "enabled": "bool"
-        },
-        "file": {
-          "enabled": "bool"
-        }
+      "kind": "StorageV2",
+      "properties": {
+        "accessTier": "Hot",
+        "minimumTlsVersion": "TLS1_2",
+        "supportsHttpsTrafficOnly": true
       }
-    },
-    "isHnsEnabled": "bool",
-    "largeFileSharesState": "string",
-    "minimumTlsVersion": "string",
-    "networkAcls": {
-      "bypass": "string",
-      "defaultAction": "string",
-      "ipRules": [
-        {
-          "action": "Allow",
-          "value": "string"
-        }
-      ],
-      "virtualNetworkRules": [
-        {
-          "action": "Allow",
-          "id": "string",
-          "state": "string"
-        }
-      ]
-    },
-    "supportsHttpsTrafficOnly": "bool"
-  }
+    }
+  ]
 }
 TFEOF
 }
\ No newline at end of file
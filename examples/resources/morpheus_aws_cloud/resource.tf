@@ -18,4 +18,8 @@ resource "morpheus_aws_cloud" "tf_example_aws_cloud" {
   guidance                   = "manual"
   costing                    = "full"
   agent_install_mode         = "cloudInit"
+  wait_for_initial_sync      = true
+  refresh_triggers           = {
+    inventory = "2026-10-19"
+  }
 }
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import morpheus_backup_integration.tf_example_backup_integration 1
\ No newline at end of file
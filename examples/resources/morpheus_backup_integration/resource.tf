@@ -0,0 +1,9 @@
+resource "morpheus_backup_integration" "tf_example_backup_integration" {
+  name       = "tfexample veeam"
+  type       = "veeam"
+  enabled    = true
+  visibility = "private"
+  url        = "https://veeam.morpheusdata.com:9398"
+  username   = "administrator"
+  password   = "password123"
+}
\ No newline at end of file
@@ -0,0 +1,11 @@
+data "morpheus_execute_schedule" "tf_example_schedule" {
+  name = "Daily at Midnight"
+}
+
+resource "morpheus_backup_job" "tf_example_backup_job" {
+  name            = "tfexample daily backup"
+  code            = "tfexample-daily-backup"
+  enabled         = true
+  schedule_id     = data.morpheus_execute_schedule.tf_example_schedule.id
+  retention_count = 7
+}
\ No newline at end of file
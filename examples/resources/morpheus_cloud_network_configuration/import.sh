@@ -0,0 +1 @@
+terraform import morpheus_cloud_network_configuration.tf_example_cloud_network_configuration 1
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import morpheus_cloud_resource_pool_configuration.tf_example_cloud_resource_pool_configuration 1:5
\ No newline at end of file
@@ -0,0 +1,25 @@
+data "morpheus_cloud" "tf_example_cloud" {
+  name = "tf_example_vsphere_cloud"
+}
+
+data "morpheus_group" "tf_example_group" {
+  name = "tf_example_group"
+}
+
+data "morpheus_tenant" "tf_example_tenant" {
+  name = "Terraform Example Tenant"
+}
+
+resource "morpheus_cloud_resource_pool_configuration" "tf_example_cloud_resource_pool_configuration" {
+  cloud_id          = data.morpheus_cloud.tf_example_cloud.id
+  name              = "Example_Resource_Pool"
+  active            = true
+  default_pool      = false
+  inventory         = true
+  visibility        = "private"
+  group_access_all  = false
+  group_access_ids  = [data.morpheus_group.tf_example_group.id]
+  group_default_ids = [data.morpheus_group.tf_example_group.id]
+  plan_access_all   = true
+  tenant_ids        = [data.morpheus_tenant.tf_example_tenant.id]
+}
\ No newline at end of file
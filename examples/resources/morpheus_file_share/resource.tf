@@ -0,0 +1,8 @@
+resource "morpheus_file_share" "tf_example_file_share" {
+  name                      = "tfexample nfs share"
+  provider_type             = "nfs"
+  host                      = "nfs.morpheusdata.com"
+  export_folder             = "/exports/morpheus"
+  default_backup_target     = true
+  default_deployment_target = true
+}
\ No newline at end of file
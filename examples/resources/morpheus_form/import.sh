@@ -0,0 +1 @@
+terraform import morpheus_form.tf_example_form 1
\ No newline at end of file
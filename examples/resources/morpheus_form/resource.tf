@@ -0,0 +1,58 @@
+data "morpheus_option_list" "regions" {
+  name = "AWS Regions"
+}
+
+resource "morpheus_form" "tf_example_form" {
+  name        = "tf_example_form"
+  code        = "tf-example-form"
+  description = "Terraform form example"
+  labels      = ["demo", "terraform"]
+
+  field {
+    type        = "text"
+    code        = "app-name"
+    field_name  = "appName"
+    field_label = "Application Name"
+    placeholder = "myapp"
+    required    = true
+    help_block  = "Lowercase letters and numbers only"
+
+    verify_pattern = "^[a-z0-9]+$"
+  }
+
+  field {
+    type           = "select"
+    code           = "region"
+    field_name     = "region"
+    field_label    = "Region"
+    option_list_id = data.morpheus_option_list.regions.id
+    default_value  = "us-east-1"
+    required       = true
+  }
+
+  field_group {
+    name        = "Advanced"
+    collapsible = true
+
+    default_collapsed = true
+
+    field {
+      type          = "checkbox"
+      code          = "enable-backups"
+      field_name    = "enableBackups"
+      field_label   = "Enable Backups"
+      default_value = "off"
+    }
+
+    field {
+      type             = "number"
+      field_name       = "retentionDays"
+      field_label      = "Retention Days"
+      default_value    = "7"
+      min_value        = 1
+      max_value        = 90
+      visibility_field = "enable-backups:on"
+      require_field    = "enable-backups:on"
+    }
+  }
+}
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import morpheus_gcp_cloud.tf_example_gcp_cloud 1
\ No newline at end of file
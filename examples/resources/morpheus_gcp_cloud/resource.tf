@@ -0,0 +1,19 @@
+resource "morpheus_gcp_cloud" "tf_example_gcp_cloud" {
+  name                       = "tf-gcp-demo"
+  code                       = "tf-gcp-demo"
+  location                   = "iowa"
+  visibility                 = "public"
+  tenant_id                  = 1
+  enabled                    = true
+  automatically_power_on_vms = true
+  project_id                 = "tf-demo-project"
+  region                     = "us-central1"
+  service_account_json       = file("${path.module}/service-account.json")
+  inventory                  = "full"
+  appliance_url              = "https://morpheus.local"
+  time_zone                  = "America/Chicago"
+  datacenter_id              = "tfgcpdemo"
+  guidance                   = "manual"
+  costing                    = "full"
+  agent_install_mode         = "cloudInit"
+}
\ No newline at end of file
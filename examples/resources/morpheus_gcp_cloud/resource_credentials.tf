@@ -0,0 +1,23 @@
+data "morpheus_credential" "gcp_credentials" {
+  name = "gcpdemo"
+}
+
+resource "morpheus_gcp_cloud" "tf_example_gcp_cloud" {
+  name                       = "tf-gcp-demo"
+  code                       = "tf-gcp-demo"
+  location                   = "iowa"
+  visibility                 = "public"
+  tenant_id                  = 1
+  enabled                    = true
+  automatically_power_on_vms = true
+  project_id                 = "tf-demo-project"
+  region                     = "us-central1"
+  credential_id              = data.morpheus_credential.gcp_credentials.id
+  inventory                  = "full"
+  appliance_url              = "https://morpheus.local"
+  time_zone                  = "America/Chicago"
+  datacenter_id              = "tfgcpdemo"
+  guidance                   = "manual"
+  costing                    = "full"
+  agent_install_mode         = "cloudInit"
+}
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import morpheus_infoblox_integration.tf_example_infoblox_integration 1
\ No newline at end of file
@@ -0,0 +1,16 @@
+resource "morpheus_infoblox_integration" "tf_example_infoblox_integration" {
+  name               = "tfexample infoblox"
+  enabled            = true
+  url                = "https://infoblox.morpheusdata.com/wapi/v2.2.1"
+  username           = "admin"
+  password           = "password123"
+  throttle_rate      = 0
+  ignore_ssl_errors  = true
+  inventory_existing = true
+  network_filter     = "network_view=default"
+  zone_filter        = "*.morpheusdata.com"
+  tenant_match       = "Tenant"
+  extra_attributes = jsonencode({
+    "Owner" : "Terraform"
+  })
+}
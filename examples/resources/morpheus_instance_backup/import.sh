@@ -0,0 +1 @@
+terraform import morpheus_instance_backup.tf_example_instance_backup 1
\ No newline at end of file
@@ -0,0 +1,10 @@
+data "morpheus_instances" "tf_example_instances" {
+  name_regex = "^tfexample-instance$"
+}
+
+resource "morpheus_instance_backup" "tf_example_instance_backup" {
+  name          = "tfexample instance backup"
+  instance_id   = data.morpheus_instances.tf_example_instances.ids[0]
+  backup_job_id = morpheus_backup_job.tf_example_backup_job.id
+  enabled       = true
+}
\ No newline at end of file
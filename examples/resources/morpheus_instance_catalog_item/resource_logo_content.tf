@@ -0,0 +1,10 @@
+resource "morpheus_instance_catalog_item" "tf_example_instance_catalog_item_logo" {
+  name                = "tfexample_instance_catalog_logo"
+  description         = "terraform example instance catalog item"
+  image_name          = "tfexample.png"
+  logo_content_base64 = filebase64("${path.module}/tfexample.png")
+  config              = <<TFEOF
+  {"name":"test"}
+  TFEOF
+  visibility          = "private"
+}
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import morpheus_integration.tf_example_integration 1
\ No newline at end of file
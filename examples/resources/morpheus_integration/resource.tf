@@ -0,0 +1,25 @@
+resource "morpheus_integration" "tf_example_integration" {
+  name     = "tfexample jenkins"
+  type     = "jenkins"
+  enabled  = true
+  url      = "https://jenkins.morpheusdata.com"
+  username = "admin"
+  password = "password123"
+}
+
+resource "morpheus_integration" "tf_example_chef_integration" {
+  name = "tfexample chef"
+  type = "chef"
+  url  = "https://chef.morpheusdata.com"
+
+  config = {
+    org            = "morpheus"
+    username       = "admin"
+    chefUseFqdn    = "on"
+    windowsVersion = "17.10.0"
+  }
+
+  sensitive_config = {
+    userKey = file("${path.module}/admin.pem")
+  }
+}
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import morpheus_kubernetes_cloud.tf_example_kubernetes_cloud 1
\ No newline at end of file
@@ -0,0 +1,12 @@
+resource "morpheus_kubernetes_cloud" "tf_example_kubernetes_cloud" {
+  name                  = "tf-kubernetes-demo"
+  code                  = "tf-kubernetes-demo"
+  location              = "denver"
+  visibility            = "private"
+  tenant_id             = 1
+  enabled               = true
+  api_url               = "https://k8s.example.com:6443"
+  service_account_token = var.kubernetes_service_account_token
+  guidance              = "off"
+  costing               = "costing"
+}
\ No newline at end of file
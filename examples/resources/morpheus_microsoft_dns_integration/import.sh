@@ -0,0 +1 @@
+terraform import morpheus_microsoft_dns_integration.tf_example_microsoft_dns_integration 1
\ No newline at end of file
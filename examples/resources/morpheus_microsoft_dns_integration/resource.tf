@@ -0,0 +1,10 @@
+resource "morpheus_microsoft_dns_integration" "tf_example_microsoft_dns_integration" {
+  name                   = "tfexample microsoft dns"
+  enabled                = true
+  server                 = "dns01.morpheusdata.com"
+  username               = "MORPHEUS\\administrator"
+  password               = "password123"
+  zone_filter            = "*.morpheusdata.com"
+  create_pointer_records = true
+  inventory_existing     = true
+}
@@ -0,0 +1 @@
+terraform import morpheus_network_domain_record.tf_example_network_domain_record 1:5
\ No newline at end of file
@@ -0,0 +1,8 @@
+resource "morpheus_network_domain_record" "tf_example_network_domain_record" {
+  network_domain_id = 1
+  name              = "tfexample"
+  type              = "A"
+  content           = "10.100.10.25"
+  ttl               = 3600
+  comments          = "Terraform example network domain record"
+}
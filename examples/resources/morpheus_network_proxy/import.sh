@@ -0,0 +1 @@
+terraform import morpheus_network_proxy.tf_example_network_proxy 1
\ No newline at end of file
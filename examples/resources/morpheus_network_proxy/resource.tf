@@ -0,0 +1,10 @@
+resource "morpheus_network_proxy" "tf_example_network_proxy" {
+  name       = "tfexampleproxy"
+  host       = "proxy.example.com"
+  port       = 3128
+  username   = "proxyuser"
+  password   = "Password123"
+  exclusions = ["localhost", "*.example.com", "10.0.0.0/8"]
+  visibility = "private"
+  tenant_id  = 1
+}
@@ -0,0 +1,28 @@
+resource "morpheus_network_router" "tf_example_network_router" {
+  name        = "tfexamplerouter"
+  description = "Terraform example network router"
+  type_code   = "openstackRouter"
+  cloud_id    = 1
+  group_id    = 1
+  enabled     = true
+
+  interface {
+    name           = "internal"
+    network_id     = 10
+    ip_address     = "10.10.0.1"
+    interface_type = "internal"
+  }
+
+  route {
+    name        = "datacenter"
+    destination = "192.168.0.0/16"
+    next_hop    = "10.10.0.254"
+  }
+
+  nat {
+    name               = "outbound"
+    action             = "SNAT"
+    source_network     = "10.10.0.0/24"
+    translated_network = "203.0.113.10"
+  }
+}
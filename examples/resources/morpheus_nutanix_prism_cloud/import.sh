@@ -0,0 +1 @@
+terraform import morpheus_nutanix_prism_cloud.tf_example_nutanix_prism_cloud 1
\ No newline at end of file
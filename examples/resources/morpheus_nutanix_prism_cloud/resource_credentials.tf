@@ -0,0 +1,24 @@
+data "morpheus_credential" "nutanix_credentials" {
+  name = "nutanixdemo"
+}
+
+resource "morpheus_nutanix_prism_cloud" "tf_example_nutanix_prism_cloud" {
+  name                       = "tf-nutanix-demo"
+  code                       = "tf-nutanix-demo"
+  location                   = "denver"
+  visibility                 = "public"
+  tenant_id                  = 1
+  enabled                    = true
+  automatically_power_on_vms = true
+  api_url                    = "https://prism.example.com:9440"
+  credential_id              = data.morpheus_credential.nutanix_credentials.id
+  cluster                    = "all"
+  import_existing_vms        = true
+  inventory                  = "full"
+  appliance_url              = "https://morpheus.local"
+  time_zone                  = "America/Denver"
+  datacenter_id              = "tfnutanixdemo"
+  guidance                   = "manual"
+  costing                    = "full"
+  agent_install_mode         = "ssh"
+}
\ No newline at end of file
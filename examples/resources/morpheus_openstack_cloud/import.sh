@@ -0,0 +1 @@
+terraform import morpheus_openstack_cloud.tf_example_openstack_cloud 1
\ No newline at end of file
@@ -0,0 +1,26 @@
+data "morpheus_credential" "openstack_credentials" {
+  name = "openstackdemo"
+}
+
+resource "morpheus_openstack_cloud" "tf_example_openstack_cloud" {
+  name                       = "tf-openstack-demo"
+  code                       = "tf-openstack-demo"
+  location                   = "denver"
+  visibility                 = "public"
+  tenant_id                  = 1
+  enabled                    = true
+  automatically_power_on_vms = true
+  identity_url               = "https://openstack.example.com:5000/v3"
+  domain_id                  = "default"
+  project_name               = "morpheus"
+  region                     = "RegionOne"
+  credential_id              = data.morpheus_credential.openstack_credentials.id
+  image_format               = "QCOW2"
+  inventory                  = "full"
+  appliance_url              = "https://morpheus.local"
+  time_zone                  = "America/Denver"
+  datacenter_id              = "tfopenstackdemo"
+  guidance                   = "manual"
+  costing                    = "full"
+  agent_install_mode         = "cloudInit"
+}
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import morpheus_powerdns_integration.tf_example_powerdns_integration 1
\ No newline at end of file
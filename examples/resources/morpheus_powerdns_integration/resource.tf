@@ -0,0 +1,7 @@
+resource "morpheus_powerdns_integration" "tf_example_powerdns_integration" {
+  name    = "tfexample powerdns"
+  enabled = true
+  url     = "http://powerdns.morpheusdata.com:8081"
+  token   = "5fd5e3e3-3e4f-4b1c-b3a5-ce4c4f5d7b2a"
+  version = "4"
+}
@@ -1,11 +1,26 @@
 resource "morpheus_provisioning_workflow" "tf_example_provisioning_workflow" {
-  name        = "tf_example_provisioning_workflow"
-  description = "Terraform provisioning workflow example"
-  labels      = ["demo", "terraform"]
-  platform    = "all"
-  visibility  = "private"
+  name            = "tf_example_provisioning_workflow"
+  description     = "Terraform provisioning workflow example"
+  labels          = ["demo", "terraform"]
+  platform        = "all"
+  visibility      = "private"
+  option_type_ids = [12, 14]
   task {
     task_id    = 18
     task_phase = "configure"
   }
+  task {
+    task_id    = 21
+    task_phase = "postProvision"
+    order      = 2
+  }
+  task {
+    task_id    = 20
+    task_phase = "postProvision"
+    order      = 1
+    target     = "resource"
+    options = {
+      "shell.sudo" = "on"
+    }
+  }
 }
\ No newline at end of file
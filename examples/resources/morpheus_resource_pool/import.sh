@@ -0,0 +1 @@
+terraform import morpheus_resource_pool.tf_example_resource_pool 1:5
\ No newline at end of file
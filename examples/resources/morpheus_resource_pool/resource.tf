@@ -0,0 +1,21 @@
+data "morpheus_cloud" "tf_example_aws_cloud" {
+  name = "tf_example_aws_cloud"
+}
+
+data "morpheus_group" "tf_example_group" {
+  name = "tf_example_group"
+}
+
+resource "morpheus_resource_pool" "tf_example_resource_pool" {
+  cloud_id          = data.morpheus_cloud.tf_example_aws_cloud.id
+  name              = "tf_example_vpc"
+  description       = "Terraform example VPC"
+  region_code       = "ec2.us-east-1"
+  cidr_block        = "10.20.0.0/16"
+  tenancy           = "default"
+  visibility        = "private"
+  group_access_all  = false
+  group_access_ids  = [data.morpheus_group.tf_example_group.id]
+  group_default_ids = [data.morpheus_group.tf_example_group.id]
+  plan_access_all   = true
+}
\ No newline at end of file
@@ -0,0 +1,18 @@
+resource "morpheus_rest_option_list" "tf_example_rest_option_list_test_input" {
+  name               = "tf_example_rest_option_list_test_input"
+  source_url         = "https://api.github.com/repos/hashicorp/consul/releases"
+  source_method      = "GET"
+  translation_script = <<EOF
+for (var x = 0; x < data.length; x++) {
+  results.push({name: data[x].name, value: data[x].tag_name});
+}
+EOF
+  test_input = jsonencode([
+    { name = "v1.18.1", tag_name = "v1.18.1" },
+    { name = "v1.17.4", tag_name = "v1.17.4" }
+  ])
+}
+
+output "tf_example_rest_option_list_test_output" {
+  value = morpheus_rest_option_list.tf_example_rest_option_list_test_input.test_output
+}
\ No newline at end of file
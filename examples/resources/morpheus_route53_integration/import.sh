@@ -0,0 +1 @@
+terraform import morpheus_route53_integration.tf_example_route53_integration 1
\ No newline at end of file
@@ -0,0 +1,7 @@
+resource "morpheus_shell_script_task" "tfexample_shell_validated" {
+  name            = "tfexample_shell_validated"
+  code            = "tfexample_shell_validated"
+  source_type     = "local"
+  script_content  = file("${path.module}/scripts/bootstrap.sh")
+  validate_syntax = true
+}
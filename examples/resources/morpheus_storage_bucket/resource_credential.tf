@@ -0,0 +1,14 @@
+resource "morpheus_credential" "tf_example_azure_storage_credential" {
+  name     = "tfexample azure storage"
+  type     = "username-password"
+  username = "tfexamplestorage"
+  password = "storageaccountkey"
+}
+
+resource "morpheus_storage_bucket" "tf_example_azure_bucket" {
+  name                         = "tfexample azure container"
+  provider_type                = "azure"
+  bucket_name                  = "morpheus-images"
+  credential_id                = morpheus_credential.tf_example_azure_storage_credential.id
+  default_virtual_image_target = true
+}
\ No newline at end of file
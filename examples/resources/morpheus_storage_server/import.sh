@@ -0,0 +1 @@
+terraform import morpheus_storage_server.tf_example_storage_server 1
\ No newline at end of file
@@ -0,0 +1,8 @@
+resource "morpheus_storage_server" "tf_example_storage_server" {
+  name       = "tfexample 3par"
+  type       = "3par"
+  visibility = "private"
+  url        = "https://3par.morpheusdata.com:8080"
+  username   = "3paradm"
+  password   = "password123"
+}
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import morpheus_storage_volume.tf_example_storage_volume 1
\ No newline at end of file
@@ -0,0 +1,17 @@
+data "morpheus_cloud" "tf_example_cloud" {
+  name = "tf_example_vsphere_cloud"
+}
+
+data "morpheus_group" "tf_example_group" {
+  name = "tf_example_group"
+}
+
+resource "morpheus_storage_volume" "tf_example_storage_volume" {
+  name              = "tfexample-datastore-01"
+  storage_server_id = morpheus_storage_server.tf_example_storage_server.id
+  type              = "3parVolume"
+  size              = 500
+  cloud_id          = data.morpheus_cloud.tf_example_cloud.id
+  visibility        = "private"
+  group_access_ids  = [data.morpheus_group.tf_example_group.id]
+}
\ No newline at end of file
@@ -0,0 +1,19 @@
+resource "morpheus_task" "tf_example_task" {
+  name                = "tf_example_rest_task"
+  code                = "tf_example_rest_task"
+  task_type_code      = "restTask"
+  labels              = ["demo", "terraform"]
+  visibility          = "private"
+  result_type         = "json"
+  execute_target      = "local"
+  retryable           = true
+  retry_count         = 3
+  retry_delay_seconds = 30
+  allow_custom_config = false
+  task_options = {
+    webUrl     = "https://api.example.com/v1/deployments"
+    webMethod  = "POST"
+    webHeaders = jsonencode([{ name = "Content-Type", value = "application/json" }])
+    webBody    = jsonencode({ environment = "production" })
+  }
+}
\ No newline at end of file
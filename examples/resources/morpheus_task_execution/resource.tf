@@ -0,0 +1,27 @@
+data "morpheus_task" "tf_example_task" {
+  name = "tf_example_shell_task"
+}
+
+data "morpheus_instances" "tf_example_instances" {
+  name_regex = "^web-"
+}
+
+resource "morpheus_task_execution" "tf_example_task_execution" {
+  task_id     = data.morpheus_task.tf_example_task.id
+  target_type = "instance"
+  target_ids  = data.morpheus_instances.tf_example_instances.ids
+  custom_options = {
+    releaseVersion = "1.4.2"
+  }
+  triggers = {
+    release = "1.4.2"
+  }
+
+  timeouts {
+    create = "15m"
+  }
+}
+
+output "tf_example_task_output" {
+  value = morpheus_task_execution.tf_example_task_execution.output
+}
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import morpheus_vault_integration.tf_example_vault_integration 1
\ No newline at end of file
@@ -0,0 +1,9 @@
+resource "morpheus_vault_integration" "tf_example_vault_integration" {
+  name        = "tfexample vault"
+  enabled     = true
+  url         = "https://vault.morpheusdata.com:8200"
+  auth_method = "token"
+  token       = "hvs.CAESIJlU9JMYEhOPYv4igdhm9PnZDrabYTobQ4Ymnlq1qY-LGh4KHGh2cy"
+  mount_path  = "secret"
+  kv_version  = 2
+}
\ No newline at end of file
@@ -0,0 +1,24 @@
+resource "morpheus_vault_integration" "tf_example_vault_integration" {
+  name        = "tfexample vault"
+  url         = "https://vault.morpheusdata.com:8200"
+  namespace   = "morpheus"
+  auth_method = "approle"
+  role_id     = "db02de05-fa39-4855-059b-67221c5c2f63"
+  secret_id   = "6a174c20-f6de-a53c-74d2-6018fcceff64"
+  mount_path  = "kv"
+  kv_version  = 1
+}
+
+resource "morpheus_credential" "tf_example_vault_credential" {
+  name                            = "tfexample vault credential"
+  type                            = "username-password"
+  credential_store_integration_id = morpheus_vault_integration.tf_example_vault_integration.id
+  username                        = "admin"
+  password                        = "password123"
+}
+
+resource "morpheus_cypher_secret" "tf_example_vault_secret" {
+  mount = "vault"
+  key   = "apipassword"
+  value = "password123"
+}
\ No newline at end of file
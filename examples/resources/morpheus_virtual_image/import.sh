@@ -0,0 +1 @@
+terraform import morpheus_virtual_image.tf_example_virtual_image 1
\ No newline at end of file
@@ -0,0 +1,12 @@
+resource "morpheus_virtual_image" "tf_example_virtual_image" {
+  name                = "ubuntu-22.04-golden"
+  image_type          = "qcow2"
+  os_type             = "ubuntu.22.04.64"
+  minimum_memory      = 2048
+  minimum_disk        = 20
+  is_cloud_init       = true
+  install_agent       = true
+  storage_provider_id = morpheus_storage_bucket.tf_example_storage_bucket.id
+  visibility          = "private"
+  source              = "${path.module}/output/ubuntu-22.04-golden.qcow2"
+}
\ No newline at end of file
@@ -0,0 +1,8 @@
+resource "morpheus_virtual_image" "tf_example_virtual_image_url" {
+  name          = "rocky-9-generic"
+  image_type    = "qcow2"
+  os_type       = "rocky.9.64"
+  is_cloud_init = true
+  source_url    = "https://dl.rockylinux.org/pub/rocky/9/images/x86_64/Rocky-9-GenericCloud.latest.x86_64.qcow2"
+  checksum      = "9.4-20240609.1"
+}
\ No newline at end of file
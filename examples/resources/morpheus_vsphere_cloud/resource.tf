@@ -32,4 +32,8 @@ resource "morpheus_vsphere_cloud" "tf_example_vsphere_cloud" {
   guidance                                = "manual"
   costing                                 = "costing"
   agent_install_mode                      = "cloudInit"
+  wait_for_initial_sync                   = true
+  refresh_triggers                        = {
+    inventory = "2026-10-19"
+  }
 }
\ No newline at end of file
@@ -0,0 +1,13 @@
+data "morpheus_workflow" "tf_example_workflow" {
+  name = "tf_example_operational_workflow"
+}
+
+resource "morpheus_workflow_execution" "tf_example_workflow_execution" {
+  workflow_id = data.morpheus_workflow.tf_example_workflow.id
+  custom_options = {
+    environment = "production"
+  }
+  triggers = {
+    workflow_version = "3"
+  }
+}
\ No newline at end of file
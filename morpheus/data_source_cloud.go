@@ -17,7 +17,7 @@ func dataSourceMorpheusCloud() *schema.Resource {
 			"id": {
 				Type:          schema.TypeInt,
 				Optional:      true,
-				ConflictsWith: []string{"name"},
+				ConflictsWith: []string{"name", "code", "labels"},
 				Computed:      true,
 			},
 			"name": {
@@ -27,9 +27,28 @@ func dataSourceMorpheusCloud() *schema.Resource {
 				ConflictsWith: []string{"id"},
 			},
 			"code": {
-				Type:        schema.TypeString,
-				Description: "Optional code for use with policies",
-				Computed:    true,
+				Type:          schema.TypeString,
+				Description:   "Optional code for use with policies",
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"id"},
+			},
+			"labels": {
+				Type:          schema.TypeSet,
+				Description:   "A list of labels the cloud must be associated with",
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"id"},
+			},
+			"tenant_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the tenant used to scope the cloud lookup",
+				Optional:    true,
+			},
+			"group_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the group used to scope the cloud lookup",
+				Optional:    true,
 			},
 			"location": {
 				Type:        schema.TypeString,
@@ -46,18 +65,35 @@ func dataSourceMorpheusCloudRead(ctx context.Context, d *schema.ResourceData, me
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
-	name := d.Get("name").(string)
 	id := d.Get("id").(int)
 
-	// lookup by name if we do not have an id yet
+	lookup := dataSourceLookup{
+		Name:   "cloud",
+		Path:   morpheus.CloudsPath,
+		Key:    "zones",
+		Code:   true,
+		Labels: true,
+		ScopePaths: map[string]string{
+			"tenant_id": "accountId",
+			"group_id":  "groups.id",
+		},
+	}
+
+	// lookup by the selectors if we do not have an id yet
 	var resp *morpheus.Response
 	var err error
-	if id == 0 && name != "" {
-		resp, err = client.FindCloudByName(name)
+	if id == 0 && lookup.hasSelector(d) {
+		// resolve the id from the selectors so that ambiguous matches are reported
+		var objectId int64
+		objectId, err = findDataSourceObjectID(client, d, lookup)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		resp, err = client.GetCloud(objectId, &morpheus.Request{})
 	} else if id != 0 {
 		resp, err = client.GetCloud(int64(id), &morpheus.Request{})
 	} else {
-		return diag.Errorf("Cloud cannot be read without name or id")
+		return diag.Errorf("Cloud cannot be read without name, code, labels or id")
 	}
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
@@ -0,0 +1,151 @@
+package morpheus
+
+import (
+	"context"
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceMorpheusClouds() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a list of Morpheus clouds that match the specified filters.",
+		ReadContext: dataSourceMorpheusCloudsRead,
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:         schema.TypeString,
+				Description:  "A regular expression used to filter the clouds by name",
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"labels": {
+				Type:        schema.TypeSet,
+				Description: "A list of labels that the clouds must all be associated with",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"type_code": {
+				Type:        schema.TypeString,
+				Description: "The code of the cloud type to filter by (i.e. - vmware)",
+				Optional:    true,
+			},
+			"tenant_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the tenant that owns the clouds to filter by",
+				Optional:    true,
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Description: "The IDs of the matching clouds",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"clouds": {
+				Type:        schema.TypeList,
+				Description: "The matching clouds",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of the cloud",
+							Computed:    true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The name of the cloud",
+							Computed:    true,
+						},
+						"code": {
+							Type:        schema.TypeString,
+							Description: "The code of the cloud",
+							Computed:    true,
+						},
+						"location": {
+							Type:        schema.TypeString,
+							Description: "The location of the cloud",
+							Computed:    true,
+						},
+						"type_code": {
+							Type:        schema.TypeString,
+							Description: "The code of the cloud type",
+							Computed:    true,
+						},
+						"tenant_id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of the tenant that owns the cloud",
+							Computed:    true,
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Description: "Whether the cloud is enabled",
+							Computed:    true,
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Description: "The status of the cloud",
+							Computed:    true,
+						},
+						"labels": {
+							Type:        schema.TypeList,
+							Description: "The labels associated with the cloud",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMorpheusCloudsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	objects, err := listAllObjects(client, morpheus.CloudsPath, "zones", map[string]string{})
+	if err != nil {
+		log.Printf("API FAILURE: %s", err)
+		return diag.FromErr(err)
+	}
+
+	filterPaths := map[string]string{
+		"type_code": "zoneType.code",
+		"tenant_id": "accountId",
+	}
+
+	var ids []int
+	var zonesOutput []map[string]interface{}
+	for _, object := range objects {
+		match, err := matchesListFilters(d, object, filterPaths)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if !match {
+			continue
+		}
+		ids = append(ids, objectInt(object, "id"))
+
+		row := make(map[string]interface{})
+		row["id"] = objectInt(object, "id")
+		row["name"] = objectString(object, "name")
+		row["code"] = objectString(object, "code")
+		row["location"] = objectString(object, "location")
+		row["type_code"] = objectString(object, "zoneType.code")
+		row["tenant_id"] = objectInt(object, "accountId")
+		row["enabled"] = objectString(object, "enabled") == "true"
+		row["status"] = objectString(object, "status")
+		row["labels"] = objectStrings(object, "labels")
+		zonesOutput = append(zonesOutput, row)
+	}
+
+	d.SetId(listDataSourceID(morpheus.CloudsPath, ids))
+	d.Set("ids", ids)
+	d.Set("clouds", zonesOutput)
+	return diags
+}
@@ -26,6 +26,11 @@ func dataSourceMorpheusCredential() *schema.Resource {
 				Optional:      true,
 				ConflictsWith: []string{"id"},
 			},
+			"tenant_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the tenant used to scope the credential lookup",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -36,14 +41,28 @@ func dataSourceMorpheusCredentialRead(ctx context.Context, d *schema.ResourceDat
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
-	name := d.Get("name").(string)
 	id := d.Get("id").(int)
 
-	// lookup by name if we do not have an id yet
+	lookup := dataSourceLookup{
+		Name: "credential",
+		Path: morpheus.CredentialsPath,
+		Key:  "credentials",
+		ScopePaths: map[string]string{
+			"tenant_id": "account.id",
+		},
+	}
+
+	// lookup by the selectors if we do not have an id yet
 	var resp *morpheus.Response
 	var err error
-	if id == 0 && name != "" {
-		resp, err = client.FindCredentialByName(name)
+	if id == 0 && lookup.hasSelector(d) {
+		// resolve the id from the selectors so that ambiguous matches are reported
+		var objectId int64
+		objectId, err = findDataSourceObjectID(client, d, lookup)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		resp, err = client.GetCredential(objectId, &morpheus.Request{})
 	} else if id != 0 {
 		resp, err = client.GetCredential(int64(id), &morpheus.Request{})
 	} else {
@@ -9,6 +9,7 @@ import (
 	"github.com/gomorpheus/morpheus-go-sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceMorpheusCypherSecret() *schema.Resource {
@@ -25,6 +26,13 @@ func dataSourceMorpheusCypherSecret() *schema.Resource {
 				Description: "The path of the cypher secret, excluding the secret prefix",
 				Required:    true,
 			},
+			"mount": {
+				Type:         schema.TypeString,
+				Description:  "The cypher mount the secret is stored in (secret, vault)",
+				Optional:     true,
+				Default:      "secret",
+				ValidateFunc: validation.StringInSlice([]string{"secret", "vault"}, false),
+			},
 			"value": {
 				Type:        schema.TypeString,
 				Description: "The cypher secret value",
@@ -47,7 +55,7 @@ func dataSourceMorpheusCypherSecretRead(ctx context.Context, d *schema.ResourceD
 
 	var resp *morpheus.Response
 	var err error
-	secretPath := fmt.Sprintf("secret/%s", d.Get("key").(string))
+	secretPath := cypherSecretPath(d)
 
 	resp, err = client.Execute(&morpheus.Request{
 		Method: "GET",
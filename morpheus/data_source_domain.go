@@ -31,6 +31,11 @@ func dataSourceMorpheusDomain() *schema.Resource {
 				Optional:      true,
 				ConflictsWith: []string{"id"},
 			},
+			"tenant_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the tenant used to scope the network domain lookup",
+				Optional:    true,
+			},
 			"description": {
 				Type:        schema.TypeString,
 				Description: "The description of the Morpheus domain",
@@ -51,14 +56,28 @@ func dataSourceMorpheusDomainRead(ctx context.Context, d *schema.ResourceData, m
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
-	name := d.Get("name").(string)
 	id := d.Get("id").(int)
 
-	// lookup by name if we do not have an id yet
+	lookup := dataSourceLookup{
+		Name: "network domain",
+		Path: morpheus.NetworkDomainsPath,
+		Key:  "networkDomains",
+		ScopePaths: map[string]string{
+			"tenant_id": "account.id",
+		},
+	}
+
+	// lookup by the selectors if we do not have an id yet
 	var resp *morpheus.Response
 	var err error
-	if id == 0 && name != "" {
-		resp, err = client.FindNetworkDomainByName(name)
+	if id == 0 && lookup.hasSelector(d) {
+		// resolve the id from the selectors so that ambiguous matches are reported
+		var objectId int64
+		objectId, err = findDataSourceObjectID(client, d, lookup)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		resp, err = client.GetNetworkDomain(objectId, &morpheus.Request{})
 	} else if id != 0 {
 		resp, err = client.GetNetworkDomain(int64(id), &morpheus.Request{})
 	} else {
@@ -17,7 +17,7 @@ func dataSourceMorpheusEnvironment() *schema.Resource {
 			"id": {
 				Type:          schema.TypeInt,
 				Optional:      true,
-				ConflictsWith: []string{"name"},
+				ConflictsWith: []string{"name", "code"},
 				Computed:      true,
 			},
 			"active": {
@@ -37,9 +37,11 @@ func dataSourceMorpheusEnvironment() *schema.Resource {
 				Computed:    true,
 			},
 			"code": {
-				Type:        schema.TypeString,
-				Description: "Optional code for use with policies",
-				Computed:    true,
+				Type:          schema.TypeString,
+				Description:   "Optional code for use with policies",
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"id"},
 			},
 			"visibility": {
 				Type:        schema.TypeString,
@@ -56,18 +58,30 @@ func dataSourceMorpheusEnvironmentRead(ctx context.Context, d *schema.ResourceDa
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
-	name := d.Get("name").(string)
 	id := d.Get("id").(int)
 
-	// lookup by name if we do not have an id yet
+	lookup := dataSourceLookup{
+		Name: "environment",
+		Path: morpheus.EnvironmentsPath,
+		Key:  "environments",
+		Code: true,
+	}
+
+	// lookup by the selectors if we do not have an id yet
 	var resp *morpheus.Response
 	var err error
-	if id == 0 && name != "" {
-		resp, err = client.FindEnvironmentByName(name)
+	if id == 0 && lookup.hasSelector(d) {
+		// resolve the id from the selectors so that ambiguous matches are reported
+		var objectId int64
+		objectId, err = findDataSourceObjectID(client, d, lookup)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		resp, err = client.GetEnvironment(objectId, &morpheus.Request{})
 	} else if id != 0 {
 		resp, err = client.GetEnvironment(int64(id), &morpheus.Request{})
 	} else {
-		return diag.Errorf("Environment cannot be read without name or id")
+		return diag.Errorf("Environment cannot be read without name, code or id")
 	}
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
@@ -36,14 +36,25 @@ func dataSourceMorpheusExecuteScheduleRead(ctx context.Context, d *schema.Resour
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
-	name := d.Get("name").(string)
 	id := d.Get("id").(int)
 
-	// lookup by name if we do not have an id yet
+	lookup := dataSourceLookup{
+		Name: "execute schedule",
+		Path: morpheus.ExecuteSchedulesPath,
+		Key:  "schedules",
+	}
+
+	// lookup by the selectors if we do not have an id yet
 	var resp *morpheus.Response
 	var err error
-	if id == 0 && name != "" {
-		resp, err = client.FindExecuteScheduleByName(name)
+	if id == 0 && lookup.hasSelector(d) {
+		// resolve the id from the selectors so that ambiguous matches are reported
+		var objectId int64
+		objectId, err = findDataSourceObjectID(client, d, lookup)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		resp, err = client.GetExecuteSchedule(objectId, &morpheus.Request{})
 	} else if id != 0 {
 		resp, err = client.GetExecuteSchedule(int64(id), &morpheus.Request{})
 	} else {
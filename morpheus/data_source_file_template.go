@@ -17,7 +17,7 @@ func dataSourceMorpheusFileTemplate() *schema.Resource {
 			"id": {
 				Type:          schema.TypeInt,
 				Optional:      true,
-				ConflictsWith: []string{"name"},
+				ConflictsWith: []string{"name", "labels"},
 				Computed:      true,
 			},
 			"name": {
@@ -26,6 +26,18 @@ func dataSourceMorpheusFileTemplate() *schema.Resource {
 				Optional:      true,
 				ConflictsWith: []string{"id"},
 			},
+			"labels": {
+				Type:          schema.TypeSet,
+				Description:   "A list of labels the file template must be associated with",
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"id"},
+			},
+			"tenant_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the tenant used to scope the file template lookup",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -36,18 +48,33 @@ func dataSourceMorpheusFileTemplateRead(ctx context.Context, d *schema.ResourceD
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
-	name := d.Get("name").(string)
 	id := d.Get("id").(int)
 
-	// lookup by name if we do not have an id yet
+	lookup := dataSourceLookup{
+		Name:   "file template",
+		Path:   morpheus.FileTemplatesPath,
+		Key:    "containerTemplates",
+		Labels: true,
+		ScopePaths: map[string]string{
+			"tenant_id": "account.id",
+		},
+	}
+
+	// lookup by the selectors if we do not have an id yet
 	var resp *morpheus.Response
 	var err error
-	if id == 0 && name != "" {
-		resp, err = client.FindFileTemplateByName(name)
+	if id == 0 && lookup.hasSelector(d) {
+		// resolve the id from the selectors so that ambiguous matches are reported
+		var objectId int64
+		objectId, err = findDataSourceObjectID(client, d, lookup)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		resp, err = client.GetFileTemplate(objectId, &morpheus.Request{})
 	} else if id != 0 {
 		resp, err = client.GetFileTemplate(int64(id), &morpheus.Request{})
 	} else {
-		return diag.Errorf("File template cannot be read without name or id")
+		return diag.Errorf("File template cannot be read without name, labels or id")
 	}
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
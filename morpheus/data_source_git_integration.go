@@ -45,14 +45,28 @@ func dataSourceMorpheusGitIntegrationRead(ctx context.Context, d *schema.Resourc
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
-	name := d.Get("name").(string)
 	id := d.Get("id").(int)
 
-	// lookup by name if we do not have an id yet
+	lookup := dataSourceLookup{
+		Name: "git integration",
+		Path: morpheus.IntegrationsPath,
+		Key:  "integrations",
+		QueryParams: map[string]string{
+			"type": "git",
+		},
+	}
+
+	// lookup by the selectors if we do not have an id yet
 	var resp *morpheus.Response
 	var err error
-	if id == 0 && name != "" {
-		resp, err = client.FindIntegrationByName(name)
+	if id == 0 && lookup.hasSelector(d) {
+		// resolve the id from the selectors so that ambiguous matches are reported
+		var objectId int64
+		objectId, err = findDataSourceObjectID(client, d, lookup)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		resp, err = client.GetIntegration(objectId, &morpheus.Request{})
 	} else if id != 0 {
 		resp, err = client.GetIntegration(int64(id), &morpheus.Request{})
 	} else {
@@ -0,0 +1,146 @@
+package morpheus
+
+import (
+	"context"
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceMorpheusGroups() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a list of Morpheus groups that match the specified filters.",
+		ReadContext: dataSourceMorpheusGroupsRead,
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:         schema.TypeString,
+				Description:  "A regular expression used to filter the groups by name",
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"labels": {
+				Type:        schema.TypeSet,
+				Description: "A list of labels that the groups must all be associated with",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"tenant_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the tenant that owns the groups to filter by",
+				Optional:    true,
+			},
+			"cloud_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of a cloud the groups must contain",
+				Optional:    true,
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Description: "The IDs of the matching groups",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"groups": {
+				Type:        schema.TypeList,
+				Description: "The matching groups",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of the group",
+							Computed:    true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The name of the group",
+							Computed:    true,
+						},
+						"code": {
+							Type:        schema.TypeString,
+							Description: "The code of the group",
+							Computed:    true,
+						},
+						"location": {
+							Type:        schema.TypeString,
+							Description: "The location of the group",
+							Computed:    true,
+						},
+						"tenant_id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of the tenant that owns the group",
+							Computed:    true,
+						},
+						"cloud_ids": {
+							Type:        schema.TypeList,
+							Description: "The IDs of the clouds in the group",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+						},
+						"labels": {
+							Type:        schema.TypeList,
+							Description: "The labels associated with the group",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMorpheusGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	objects, err := listAllObjects(client, morpheus.GroupsPath, "groups", map[string]string{})
+	if err != nil {
+		log.Printf("API FAILURE: %s", err)
+		return diag.FromErr(err)
+	}
+
+	filterPaths := map[string]string{
+		"tenant_id": "accountId",
+		"cloud_id":  "zones.id",
+	}
+
+	var ids []int
+	var groupsOutput []map[string]interface{}
+	for _, object := range objects {
+		match, err := matchesListFilters(d, object, filterPaths)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if !match {
+			continue
+		}
+		ids = append(ids, objectInt(object, "id"))
+
+		row := make(map[string]interface{})
+		row["id"] = objectInt(object, "id")
+		row["name"] = objectString(object, "name")
+		row["code"] = objectString(object, "code")
+		row["location"] = objectString(object, "location")
+		row["tenant_id"] = objectInt(object, "accountId")
+		var cloudIds []int
+		for _, v := range objectValues(object, "zones.id") {
+			if f, ok := v.(float64); ok {
+				cloudIds = append(cloudIds, int(f))
+			}
+		}
+		row["cloud_ids"] = cloudIds
+		row["labels"] = objectStrings(object, "labels")
+		groupsOutput = append(groupsOutput, row)
+	}
+
+	d.SetId(listDataSourceID(morpheus.GroupsPath, ids))
+	d.Set("ids", ids)
+	d.Set("groups", groupsOutput)
+	return diags
+}
@@ -2,7 +2,6 @@ package morpheus
 
 import (
 	"context"
-	"fmt"
 	"log"
 
 	"github.com/gomorpheus/morpheus-go-sdk"
@@ -18,7 +17,7 @@ func dataSourceMorpheusInstanceLayout() *schema.Resource {
 			"id": {
 				Type:          schema.TypeInt,
 				Optional:      true,
-				ConflictsWith: []string{"name", "version"},
+				ConflictsWith: []string{"name", "version", "code", "labels"},
 				Computed:      true,
 			},
 			"name": {
@@ -34,9 +33,18 @@ func dataSourceMorpheusInstanceLayout() *schema.Resource {
 				ConflictsWith: []string{"id"},
 			},
 			"code": {
-				Type:        schema.TypeString,
-				Description: "Optional code for use with policies",
-				Computed:    true,
+				Type:          schema.TypeString,
+				Description:   "Optional code for use with policies",
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"id"},
+			},
+			"labels": {
+				Type:          schema.TypeSet,
+				Description:   "A list of labels the instance layout must be associated with",
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"id"},
 			},
 			"description": {
 				Type:        schema.TypeString,
@@ -53,21 +61,34 @@ func dataSourceMorpheusInstanceLayoutRead(ctx context.Context, d *schema.Resourc
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
-	name := d.Get("name").(string)
 	id := d.Get("id").(int)
-	version := d.Get("version").(string)
 
-	// lookup by name if we do not have an id yet
+	lookup := dataSourceLookup{
+		Name:   "instance layout",
+		Path:   morpheus.InstanceLayoutsPath,
+		Key:    "instanceTypeLayouts",
+		Code:   true,
+		Labels: true,
+		ScopePaths: map[string]string{
+			"version": "containerVersion",
+		},
+	}
+
+	// lookup by the selectors if we do not have an id yet
 	var resp *morpheus.Response
 	var err error
-	if id == 0 && name != "" && version == "" {
-		resp, err = client.FindInstanceLayoutByName(name)
-	} else if id == 0 && name != "" && version != "" {
-		resp, err = FindInstanceLayoutByNameAndVersion(client, name, version)
+	if id == 0 && lookup.hasSelector(d) {
+		// resolve the id from the selectors so that ambiguous matches are reported
+		var objectId int64
+		objectId, err = findDataSourceObjectID(client, d, lookup)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		resp, err = client.GetInstanceLayout(objectId, &morpheus.Request{})
 	} else if id != 0 {
 		resp, err = client.GetInstanceLayout(int64(id), &morpheus.Request{})
 	} else {
-		return diag.Errorf("Instance layout cannot be read without name or id")
+		return diag.Errorf("Instance layout cannot be read without name, code, labels or id")
 	}
 
 	if err != nil {
@@ -95,23 +116,3 @@ func dataSourceMorpheusInstanceLayoutRead(ctx context.Context, d *schema.Resourc
 	}
 	return diags
 }
-
-func FindInstanceLayoutByNameAndVersion(client *morpheus.Client, name string, version string) (*morpheus.Response, error) {
-	// Find by name, then get by ID
-	resp, err := client.ListInstanceLayouts(&morpheus.Request{
-		QueryParams: map[string]string{
-			"name": name,
-			"max":  "5000",
-		},
-	})
-	if err != nil {
-		return resp, err
-	}
-	listResult := resp.Result.(*morpheus.ListInstanceLayoutsResult)
-	for _, layout := range *listResult.InstanceLayouts {
-		if layout.ContainerVersion == version {
-			return client.GetInstanceLayout(layout.ID, &morpheus.Request{})
-		}
-	}
-	return resp, fmt.Errorf("found 0 instance layouts named %v with a version of %v", name, version)
-}
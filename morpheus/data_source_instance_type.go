@@ -17,7 +17,7 @@ func dataSourceMorpheusInstanceType() *schema.Resource {
 			"id": {
 				Type:          schema.TypeInt,
 				Optional:      true,
-				ConflictsWith: []string{"name"},
+				ConflictsWith: []string{"name", "code", "labels"},
 				Computed:      true,
 			},
 			"name": {
@@ -27,9 +27,23 @@ func dataSourceMorpheusInstanceType() *schema.Resource {
 				ConflictsWith: []string{"id"},
 			},
 			"code": {
-				Type:        schema.TypeString,
-				Description: "Optional code for use with policies",
-				Computed:    true,
+				Type:          schema.TypeString,
+				Description:   "Optional code for use with policies",
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"id"},
+			},
+			"labels": {
+				Type:          schema.TypeSet,
+				Description:   "A list of labels the instance type must be associated with",
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"id"},
+			},
+			"tenant_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the tenant used to scope the instance type lookup",
+				Optional:    true,
 			},
 			"active": {
 				Type:        schema.TypeBool,
@@ -56,19 +70,35 @@ func dataSourceMorpheusInstanceTypeRead(ctx context.Context, d *schema.ResourceD
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
-	name := d.Get("name").(string)
 	id := d.Get("id").(int)
 
-	// lookup by name if we do not have an id yet
+	lookup := dataSourceLookup{
+		Name:   "instance type",
+		Path:   morpheus.InstanceTypesPath,
+		Key:    "instanceTypes",
+		Code:   true,
+		Labels: true,
+		ScopePaths: map[string]string{
+			"tenant_id": "account.id",
+		},
+	}
+
+	// lookup by the selectors if we do not have an id yet
 	var resp *morpheus.Response
 	var err error
-	if id == 0 && name != "" {
-		resp, err = client.FindInstanceTypeByName(name)
+	if id == 0 && lookup.hasSelector(d) {
+		// resolve the id from the selectors so that ambiguous matches are reported
+		var objectId int64
+		objectId, err = findDataSourceObjectID(client, d, lookup)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		resp, err = client.GetInstanceType(objectId, &morpheus.Request{})
 	} else if id != 0 {
 		resp, err = client.GetInstanceType(int64(id), &morpheus.Request{})
 		// todo: ignore 404 errors...
 	} else {
-		return diag.Errorf("Instance type cannot be read without name or id")
+		return diag.Errorf("Instance type cannot be read without name, code, labels or id")
 	}
 	if err != nil {
 		// 404 is ok?
@@ -0,0 +1,139 @@
+package morpheus
+
+import (
+	"context"
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceMorpheusInstanceTypes() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a list of Morpheus instance types that match the specified filters.",
+		ReadContext: dataSourceMorpheusInstanceTypesRead,
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:         schema.TypeString,
+				Description:  "A regular expression used to filter the instance types by name",
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"labels": {
+				Type:        schema.TypeSet,
+				Description: "A list of labels that the instance types must all be associated with",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"category": {
+				Type:        schema.TypeString,
+				Description: "The category of the instance types to filter by (i.e. - web, sql, nosql, apps, network, messaging, cache, os, cloud, utility)",
+				Optional:    true,
+			},
+			"type_code": {
+				Type:        schema.TypeString,
+				Description: "The code of the instance types to filter by",
+				Optional:    true,
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Description: "The IDs of the matching instance types",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"instance_types": {
+				Type:        schema.TypeList,
+				Description: "The matching instance types",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of the instance type",
+							Computed:    true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The name of the instance type",
+							Computed:    true,
+						},
+						"code": {
+							Type:        schema.TypeString,
+							Description: "The code of the instance type",
+							Computed:    true,
+						},
+						"category": {
+							Type:        schema.TypeString,
+							Description: "The category of the instance type",
+							Computed:    true,
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Description: "The description of the instance type",
+							Computed:    true,
+						},
+						"visibility": {
+							Type:        schema.TypeString,
+							Description: "The visibility of the instance type",
+							Computed:    true,
+						},
+						"labels": {
+							Type:        schema.TypeList,
+							Description: "The labels associated with the instance type",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMorpheusInstanceTypesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	objects, err := listAllObjects(client, morpheus.InstanceTypesPath, "instanceTypes", map[string]string{})
+	if err != nil {
+		log.Printf("API FAILURE: %s", err)
+		return diag.FromErr(err)
+	}
+
+	filterPaths := map[string]string{
+		"category":  "category",
+		"type_code": "code",
+	}
+
+	var ids []int
+	var instanceTypesOutput []map[string]interface{}
+	for _, object := range objects {
+		match, err := matchesListFilters(d, object, filterPaths)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if !match {
+			continue
+		}
+		ids = append(ids, objectInt(object, "id"))
+
+		row := make(map[string]interface{})
+		row["id"] = objectInt(object, "id")
+		row["name"] = objectString(object, "name")
+		row["code"] = objectString(object, "code")
+		row["category"] = objectString(object, "category")
+		row["description"] = objectString(object, "description")
+		row["visibility"] = objectString(object, "visibility")
+		row["labels"] = objectStrings(object, "labels")
+		instanceTypesOutput = append(instanceTypesOutput, row)
+	}
+
+	d.SetId(listDataSourceID(morpheus.InstanceTypesPath, ids))
+	d.Set("ids", ids)
+	d.Set("instance_types", instanceTypesOutput)
+	return diags
+}
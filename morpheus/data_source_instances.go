@@ -0,0 +1,157 @@
+package morpheus
+
+import (
+	"context"
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceMorpheusInstances() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a list of Morpheus instances that match the specified filters.",
+		ReadContext: dataSourceMorpheusInstancesRead,
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:         schema.TypeString,
+				Description:  "A regular expression used to filter the instances by name",
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"labels": {
+				Type:        schema.TypeSet,
+				Description: "A list of labels that the instances must all be associated with",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"type_code": {
+				Type:        schema.TypeString,
+				Description: "The code of the instance type to filter by",
+				Optional:    true,
+			},
+			"tenant_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the tenant that owns the instances to filter by",
+				Optional:    true,
+			},
+			"cloud_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the cloud the instances are provisioned in",
+				Optional:    true,
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Description: "The IDs of the matching instances",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"instances": {
+				Type:        schema.TypeList,
+				Description: "The matching instances",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of the instance",
+							Computed:    true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The name of the instance",
+							Computed:    true,
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Description: "The status of the instance",
+							Computed:    true,
+						},
+						"type_code": {
+							Type:        schema.TypeString,
+							Description: "The code of the instance type",
+							Computed:    true,
+						},
+						"cloud_id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of the cloud the instance is provisioned in",
+							Computed:    true,
+						},
+						"group_id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of the group the instance belongs to",
+							Computed:    true,
+						},
+						"plan_id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of the plan of the instance",
+							Computed:    true,
+						},
+						"tenant_id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of the tenant that owns the instance",
+							Computed:    true,
+						},
+						"labels": {
+							Type:        schema.TypeList,
+							Description: "The labels associated with the instance",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMorpheusInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	objects, err := listAllObjects(client, morpheus.InstancesPath, "instances", map[string]string{})
+	if err != nil {
+		log.Printf("API FAILURE: %s", err)
+		return diag.FromErr(err)
+	}
+
+	filterPaths := map[string]string{
+		"type_code": "instanceType.code",
+		"tenant_id": "tenant.id",
+		"cloud_id":  "cloud.id",
+	}
+
+	var ids []int
+	var instancesOutput []map[string]interface{}
+	for _, object := range objects {
+		match, err := matchesListFilters(d, object, filterPaths)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if !match {
+			continue
+		}
+		ids = append(ids, objectInt(object, "id"))
+
+		row := make(map[string]interface{})
+		row["id"] = objectInt(object, "id")
+		row["name"] = objectString(object, "name")
+		row["status"] = objectString(object, "status")
+		row["type_code"] = objectString(object, "instanceType.code")
+		row["cloud_id"] = objectInt(object, "cloud.id")
+		row["group_id"] = objectInt(object, "group.id")
+		row["plan_id"] = objectInt(object, "plan.id")
+		row["tenant_id"] = objectInt(object, "tenant.id")
+		row["labels"] = objectStrings(object, "labels")
+		instancesOutput = append(instancesOutput, row)
+	}
+
+	d.SetId(listDataSourceID(morpheus.InstancesPath, ids))
+	d.Set("ids", ids)
+	d.Set("instances", instancesOutput)
+	return diags
+}
@@ -18,7 +18,7 @@ func dataSourceMorpheusJob() *schema.Resource {
 				Type:          schema.TypeInt,
 				Description:   "The ID of the job",
 				Optional:      true,
-				ConflictsWith: []string{"name"},
+				ConflictsWith: []string{"name", "labels"},
 				Computed:      true,
 			},
 			"name": {
@@ -27,6 +27,13 @@ func dataSourceMorpheusJob() *schema.Resource {
 				Optional:      true,
 				ConflictsWith: []string{"id"},
 			},
+			"labels": {
+				Type:          schema.TypeSet,
+				Description:   "A list of labels the job must be associated with",
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"id"},
+			},
 		},
 	}
 }
@@ -37,18 +44,30 @@ func dataSourceMorpheusJobRead(ctx context.Context, d *schema.ResourceData, meta
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
-	name := d.Get("name").(string)
 	id := d.Get("id").(int)
 
-	// lookup by name if we do not have an id yet
+	lookup := dataSourceLookup{
+		Name:   "job",
+		Path:   morpheus.JobsPath,
+		Key:    "jobs",
+		Labels: true,
+	}
+
+	// lookup by the selectors if we do not have an id yet
 	var resp *morpheus.Response
 	var err error
-	if id == 0 && name != "" {
-		resp, err = client.FindJobByName(name)
+	if id == 0 && lookup.hasSelector(d) {
+		// resolve the id from the selectors so that ambiguous matches are reported
+		var objectId int64
+		objectId, err = findDataSourceObjectID(client, d, lookup)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		resp, err = client.GetJob(objectId, &morpheus.Request{})
 	} else if id != 0 {
 		resp, err = client.GetJob(int64(id), &morpheus.Request{})
 	} else {
-		return diag.Errorf("Job cannot be read without name or id")
+		return diag.Errorf("Job cannot be read without name, labels or id")
 	}
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
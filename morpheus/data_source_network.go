@@ -17,7 +17,7 @@ func dataSourceMorpheusNetwork() *schema.Resource {
 			"id": {
 				Type:          schema.TypeInt,
 				Optional:      true,
-				ConflictsWith: []string{"name"},
+				ConflictsWith: []string{"name", "code", "labels"},
 				Computed:      true,
 			},
 			"name": {
@@ -26,6 +26,34 @@ func dataSourceMorpheusNetwork() *schema.Resource {
 				Optional:      true,
 				ConflictsWith: []string{"id"},
 			},
+			"code": {
+				Type:          schema.TypeString,
+				Description:   "The code of the network",
+				Optional:      true,
+				ConflictsWith: []string{"id"},
+			},
+			"labels": {
+				Type:          schema.TypeSet,
+				Description:   "A list of labels the network must be associated with",
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"id"},
+			},
+			"cloud_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the cloud used to scope the network lookup",
+				Optional:    true,
+			},
+			"group_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the group used to scope the network lookup",
+				Optional:    true,
+			},
+			"tenant_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the tenant used to scope the network lookup",
+				Optional:    true,
+			},
 			"active": {
 				Type:        schema.TypeBool,
 				Description: "Whether the network is active or not",
@@ -51,18 +79,36 @@ func dataSourceMorpheusNetworkRead(ctx context.Context, d *schema.ResourceData,
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
-	name := d.Get("name").(string)
 	id := d.Get("id").(int)
 
-	// lookup by name if we do not have an id yet
+	lookup := dataSourceLookup{
+		Name:   "network",
+		Path:   morpheus.NetworksPath,
+		Key:    "networks",
+		Code:   true,
+		Labels: true,
+		ScopePaths: map[string]string{
+			"cloud_id":  "zone.id",
+			"group_id":  "site.id",
+			"tenant_id": "owner.id",
+		},
+	}
+
+	// lookup by the selectors if we do not have an id yet
 	var resp *morpheus.Response
 	var err error
-	if id == 0 && name != "" {
-		resp, err = client.FindNetworkByName(name)
+	if id == 0 && lookup.hasSelector(d) {
+		// resolve the id from the selectors so that ambiguous matches are reported
+		var objectId int64
+		objectId, err = findDataSourceObjectID(client, d, lookup)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		resp, err = client.GetNetwork(objectId, &morpheus.Request{})
 	} else if id != 0 {
 		resp, err = client.GetNetwork(int64(id), &morpheus.Request{})
 	} else {
-		return diag.Errorf("Network cannot be read without name or id")
+		return diag.Errorf("Network cannot be read without name, code, labels or id")
 	}
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
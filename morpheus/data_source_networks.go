@@ -0,0 +1,157 @@
+package morpheus
+
+import (
+	"context"
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceMorpheusNetworks() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a list of Morpheus networks that match the specified filters.",
+		ReadContext: dataSourceMorpheusNetworksRead,
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:         schema.TypeString,
+				Description:  "A regular expression used to filter the networks by name",
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"labels": {
+				Type:        schema.TypeSet,
+				Description: "A list of labels that the networks must all be associated with",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"type_code": {
+				Type:        schema.TypeString,
+				Description: "The code of the network type to filter by (i.e. - vlan)",
+				Optional:    true,
+			},
+			"tenant_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the tenant that owns the networks to filter by",
+				Optional:    true,
+			},
+			"cloud_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the cloud the networks belong to",
+				Optional:    true,
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Description: "The IDs of the matching networks",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"networks": {
+				Type:        schema.TypeList,
+				Description: "The matching networks",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of the network",
+							Computed:    true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The name of the network",
+							Computed:    true,
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Description: "The display name of the network",
+							Computed:    true,
+						},
+						"cidr": {
+							Type:        schema.TypeString,
+							Description: "The CIDR of the network",
+							Computed:    true,
+						},
+						"vlan_id": {
+							Type:        schema.TypeInt,
+							Description: "The VLAN ID of the network",
+							Computed:    true,
+						},
+						"type_code": {
+							Type:        schema.TypeString,
+							Description: "The code of the network type",
+							Computed:    true,
+						},
+						"cloud_id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of the cloud the network belongs to",
+							Computed:    true,
+						},
+						"tenant_id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of the tenant that owns the network",
+							Computed:    true,
+						},
+						"labels": {
+							Type:        schema.TypeList,
+							Description: "The labels associated with the network",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMorpheusNetworksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	objects, err := listAllObjects(client, morpheus.NetworksPath, "networks", map[string]string{})
+	if err != nil {
+		log.Printf("API FAILURE: %s", err)
+		return diag.FromErr(err)
+	}
+
+	filterPaths := map[string]string{
+		"type_code": "type.code",
+		"tenant_id": "owner.id",
+		"cloud_id":  "zone.id",
+	}
+
+	var ids []int
+	var networksOutput []map[string]interface{}
+	for _, object := range objects {
+		match, err := matchesListFilters(d, object, filterPaths)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if !match {
+			continue
+		}
+		ids = append(ids, objectInt(object, "id"))
+
+		row := make(map[string]interface{})
+		row["id"] = objectInt(object, "id")
+		row["name"] = objectString(object, "name")
+		row["display_name"] = objectString(object, "displayName")
+		row["cidr"] = objectString(object, "cidr")
+		row["vlan_id"] = objectInt(object, "vlanId")
+		row["type_code"] = objectString(object, "type.code")
+		row["cloud_id"] = objectInt(object, "zone.id")
+		row["tenant_id"] = objectInt(object, "owner.id")
+		row["labels"] = objectStrings(object, "labels")
+		networksOutput = append(networksOutput, row)
+	}
+
+	d.SetId(listDataSourceID(morpheus.NetworksPath, ids))
+	d.Set("ids", ids)
+	d.Set("networks", networksOutput)
+	return diags
+}
@@ -18,7 +18,7 @@ func dataSourceMorpheusNodeType() *schema.Resource {
 				Type:          schema.TypeInt,
 				Description:   "The ID of the node type",
 				Optional:      true,
-				ConflictsWith: []string{"name"},
+				ConflictsWith: []string{"name", "code", "labels"},
 				Computed:      true,
 			},
 			"name": {
@@ -27,6 +27,24 @@ func dataSourceMorpheusNodeType() *schema.Resource {
 				Optional:      true,
 				ConflictsWith: []string{"id"},
 			},
+			"code": {
+				Type:          schema.TypeString,
+				Description:   "The code of the node type",
+				Optional:      true,
+				ConflictsWith: []string{"id"},
+			},
+			"labels": {
+				Type:          schema.TypeSet,
+				Description:   "A list of labels the node type must be associated with",
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"id"},
+			},
+			"tenant_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the tenant used to scope the node type lookup",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -37,18 +55,34 @@ func dataSourceMorpheusNodeTypeRead(ctx context.Context, d *schema.ResourceData,
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
-	name := d.Get("name").(string)
 	id := d.Get("id").(int)
 
-	// lookup by name if we do not have an id yet
+	lookup := dataSourceLookup{
+		Name:   "node type",
+		Path:   morpheus.NodeTypesPath,
+		Key:    "containerTypes",
+		Code:   true,
+		Labels: true,
+		ScopePaths: map[string]string{
+			"tenant_id": "account.id",
+		},
+	}
+
+	// lookup by the selectors if we do not have an id yet
 	var resp *morpheus.Response
 	var err error
-	if id == 0 && name != "" {
-		resp, err = client.FindNodeTypeByName(name)
+	if id == 0 && lookup.hasSelector(d) {
+		// resolve the id from the selectors so that ambiguous matches are reported
+		var objectId int64
+		objectId, err = findDataSourceObjectID(client, d, lookup)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		resp, err = client.GetNodeType(objectId, &morpheus.Request{})
 	} else if id != 0 {
 		resp, err = client.GetNodeType(int64(id), &morpheus.Request{})
 	} else {
-		return diag.Errorf("Node type cannot be read without name or id")
+		return diag.Errorf("Node type cannot be read without name, code, labels or id")
 	}
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
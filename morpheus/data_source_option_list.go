@@ -18,7 +18,7 @@ func dataSourceMorpheusOptionList() *schema.Resource {
 				Type:          schema.TypeInt,
 				Description:   "The ID of the option list",
 				Optional:      true,
-				ConflictsWith: []string{"name"},
+				ConflictsWith: []string{"name", "labels"},
 				Computed:      true,
 			},
 			"name": {
@@ -27,6 +27,18 @@ func dataSourceMorpheusOptionList() *schema.Resource {
 				Optional:      true,
 				ConflictsWith: []string{"id"},
 			},
+			"labels": {
+				Type:          schema.TypeSet,
+				Description:   "A list of labels the option list must be associated with",
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"id"},
+			},
+			"tenant_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the tenant used to scope the option list lookup",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -37,18 +49,33 @@ func dataSourceMorpheusOptionListRead(ctx context.Context, d *schema.ResourceDat
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
-	name := d.Get("name").(string)
 	id := d.Get("id").(int)
 
-	// lookup by name if we do not have an id yet
+	lookup := dataSourceLookup{
+		Name:   "option list",
+		Path:   morpheus.OptionListsPath,
+		Key:    "optionTypeLists",
+		Labels: true,
+		ScopePaths: map[string]string{
+			"tenant_id": "account.id",
+		},
+	}
+
+	// lookup by the selectors if we do not have an id yet
 	var resp *morpheus.Response
 	var err error
-	if id == 0 && name != "" {
-		resp, err = client.FindOptionListByName(name)
+	if id == 0 && lookup.hasSelector(d) {
+		// resolve the id from the selectors so that ambiguous matches are reported
+		var objectId int64
+		objectId, err = findDataSourceObjectID(client, d, lookup)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		resp, err = client.GetOptionList(objectId, &morpheus.Request{})
 	} else if id != 0 {
 		resp, err = client.GetOptionList(int64(id), &morpheus.Request{})
 	} else {
-		return diag.Errorf("Option list cannot be read without name or id")
+		return diag.Errorf("Option list cannot be read without name, labels or id")
 	}
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
@@ -0,0 +1,89 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceMorpheusOptionListPreview() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides the options returned by a Morpheus option list for a set of input values, the same way the option list is evaluated by a form.",
+		ReadContext: dataSourceMorpheusOptionListPreviewRead,
+		Schema: map[string]*schema.Schema{
+			"option_list_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the option list to evaluate",
+				Required:    true,
+			},
+			"input": {
+				Type:        schema.TypeMap,
+				Description: "The input values passed to the option list, keyed by the parameter name (i.e. - zoneId, siteId or the field name of another option type)",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"options": {
+				Type:        schema.TypeList,
+				Description: "The options returned by the option list",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The name of the option",
+							Computed:    true,
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Description: "The value of the option",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMorpheusOptionListPreviewRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Get("option_list_id").(int)
+
+	queryParams := make(map[string]string)
+	for k, v := range d.Get("input").(map[string]interface{}) {
+		queryParams[k] = v.(string)
+	}
+
+	resp, err := client.GetOptionSource(fmt.Sprintf("list/%d", id), &morpheus.Request{
+		QueryParams: queryParams,
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %v", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	result := resp.Result.(*morpheus.GetOptionSourceResult)
+	var options []map[string]interface{}
+	if result.Data != nil {
+		for _, option := range *result.Data {
+			row := make(map[string]interface{})
+			row["name"] = option.Name
+			row["value"] = optionListScriptValue(option.Value)
+			options = append(options, row)
+		}
+	}
+
+	d.SetId(int64ToString(int64(id)))
+	d.Set("options", options)
+	return diags
+}
@@ -18,7 +18,7 @@ func dataSourceMorpheusOptionType() *schema.Resource {
 				Type:          schema.TypeInt,
 				Optional:      true,
 				Computed:      true,
-				ConflictsWith: []string{"name"},
+				ConflictsWith: []string{"name", "code", "labels"},
 			},
 			"name": {
 				Type:          schema.TypeString,
@@ -26,6 +26,19 @@ func dataSourceMorpheusOptionType() *schema.Resource {
 				Optional:      true,
 				ConflictsWith: []string{"id"},
 			},
+			"code": {
+				Type:          schema.TypeString,
+				Description:   "The code of the option type",
+				Optional:      true,
+				ConflictsWith: []string{"id"},
+			},
+			"labels": {
+				Type:          schema.TypeSet,
+				Description:   "A list of labels the option type must be associated with",
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"id"},
+			},
 		},
 	}
 }
@@ -36,18 +49,31 @@ func dataSourceMorpheusOptionTypeRead(ctx context.Context, d *schema.ResourceDat
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
-	name := d.Get("name").(string)
 	id := d.Get("id").(int)
 
-	// lookup by name if we do not have an id yet
+	lookup := dataSourceLookup{
+		Name:   "option type",
+		Path:   morpheus.OptionTypesPath,
+		Key:    "optionTypes",
+		Code:   true,
+		Labels: true,
+	}
+
+	// lookup by the selectors if we do not have an id yet
 	var resp *morpheus.Response
 	var err error
-	if id == 0 && name != "" {
-		resp, err = client.FindOptionTypeByName(name)
+	if id == 0 && lookup.hasSelector(d) {
+		// resolve the id from the selectors so that ambiguous matches are reported
+		var objectId int64
+		objectId, err = findDataSourceObjectID(client, d, lookup)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		resp, err = client.GetOptionType(objectId, &morpheus.Request{})
 	} else if id != 0 {
 		resp, err = client.GetOptionType(int64(id), &morpheus.Request{})
 	} else {
-		return diag.Errorf("Option type cannot be read without name or id")
+		return diag.Errorf("Option type cannot be read without name, code, labels or id")
 	}
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
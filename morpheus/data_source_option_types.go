@@ -0,0 +1,127 @@
+package morpheus
+
+import (
+	"context"
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceMorpheusOptionTypes() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a list of Morpheus option types that match the specified filters.",
+		ReadContext: dataSourceMorpheusOptionTypesRead,
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:         schema.TypeString,
+				Description:  "A regular expression used to filter the option types by name",
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"labels": {
+				Type:        schema.TypeSet,
+				Description: "A list of labels that the option types must all be associated with",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"type_code": {
+				Type:        schema.TypeString,
+				Description: "The type of the option types to filter by (i.e. - text, select, checkbox)",
+				Optional:    true,
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Description: "The IDs of the matching option types",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"option_types": {
+				Type:        schema.TypeList,
+				Description: "The matching option types",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of the option type",
+							Computed:    true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The name of the option type",
+							Computed:    true,
+						},
+						"field_name": {
+							Type:        schema.TypeString,
+							Description: "The field name of the option type",
+							Computed:    true,
+						},
+						"label": {
+							Type:        schema.TypeString,
+							Description: "The label of the option type",
+							Computed:    true,
+						},
+						"type_code": {
+							Type:        schema.TypeString,
+							Description: "The type of the option type",
+							Computed:    true,
+						},
+						"labels": {
+							Type:        schema.TypeList,
+							Description: "The labels associated with the option type",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMorpheusOptionTypesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	objects, err := listAllObjects(client, morpheus.OptionTypesPath, "optionTypes", map[string]string{})
+	if err != nil {
+		log.Printf("API FAILURE: %s", err)
+		return diag.FromErr(err)
+	}
+
+	filterPaths := map[string]string{
+		"type_code": "type",
+	}
+
+	var ids []int
+	var optionTypesOutput []map[string]interface{}
+	for _, object := range objects {
+		match, err := matchesListFilters(d, object, filterPaths)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if !match {
+			continue
+		}
+		ids = append(ids, objectInt(object, "id"))
+
+		row := make(map[string]interface{})
+		row["id"] = objectInt(object, "id")
+		row["name"] = objectString(object, "name")
+		row["field_name"] = objectString(object, "fieldName")
+		row["label"] = objectString(object, "fieldLabel")
+		row["type_code"] = objectString(object, "type")
+		row["labels"] = objectStrings(object, "labels")
+		optionTypesOutput = append(optionTypesOutput, row)
+	}
+
+	d.SetId(listDataSourceID(morpheus.OptionTypesPath, ids))
+	d.Set("ids", ids)
+	d.Set("option_types", optionTypesOutput)
+	return diags
+}
@@ -17,7 +17,7 @@ func dataSourceMorpheusPlan() *schema.Resource {
 			"id": {
 				Type:          schema.TypeInt,
 				Optional:      true,
-				ConflictsWith: []string{"name"},
+				ConflictsWith: []string{"name", "code"},
 				Computed:      true,
 			},
 			"name": {
@@ -27,9 +27,11 @@ func dataSourceMorpheusPlan() *schema.Resource {
 				ConflictsWith: []string{"id"},
 			},
 			"code": {
-				Type:        schema.TypeString,
-				Description: "Optional code for use with policies",
-				Computed:    true,
+				Type:          schema.TypeString,
+				Description:   "Optional code for use with policies",
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"id"},
 			},
 			"description": {
 				Type:        schema.TypeString,
@@ -46,18 +48,30 @@ func dataSourceMorpheusPlanRead(ctx context.Context, d *schema.ResourceData, met
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
-	name := d.Get("name").(string)
 	id := d.Get("id").(int)
 
-	// lookup by name if we do not have an id yet
+	lookup := dataSourceLookup{
+		Name: "plan",
+		Path: morpheus.PlansPath,
+		Key:  "servicePlans",
+		Code: true,
+	}
+
+	// lookup by the selectors if we do not have an id yet
 	var resp *morpheus.Response
 	var err error
-	if id == 0 && name != "" {
-		resp, err = client.FindPlanByName(name)
+	if id == 0 && lookup.hasSelector(d) {
+		// resolve the id from the selectors so that ambiguous matches are reported
+		var objectId int64
+		objectId, err = findDataSourceObjectID(client, d, lookup)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		resp, err = client.GetPlan(objectId, &morpheus.Request{})
 	} else if id != 0 {
 		resp, err = client.GetPlan(int64(id), &morpheus.Request{})
 	} else {
-		return diag.Errorf("Plan cannot be read without name or id")
+		return diag.Errorf("Plan cannot be read without name, code or id")
 	}
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
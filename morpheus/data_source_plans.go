@@ -0,0 +1,139 @@
+package morpheus
+
+import (
+	"context"
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceMorpheusPlans() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a list of Morpheus plans that match the specified filters.",
+		ReadContext: dataSourceMorpheusPlansRead,
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:         schema.TypeString,
+				Description:  "A regular expression used to filter the plans by name",
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"labels": {
+				Type:        schema.TypeSet,
+				Description: "A list of labels that the plans must all be associated with",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"type_code": {
+				Type:        schema.TypeString,
+				Description: "The code of the provision type of the plans to filter by (i.e. - vmware)",
+				Optional:    true,
+			},
+			"tenant_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the tenant that owns the plans to filter by",
+				Optional:    true,
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Description: "The IDs of the matching plans",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"plans": {
+				Type:        schema.TypeList,
+				Description: "The matching plans",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of the plan",
+							Computed:    true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The name of the plan",
+							Computed:    true,
+						},
+						"code": {
+							Type:        schema.TypeString,
+							Description: "The code of the plan",
+							Computed:    true,
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Description: "The description of the plan",
+							Computed:    true,
+						},
+						"type_code": {
+							Type:        schema.TypeString,
+							Description: "The code of the provision type of the plan",
+							Computed:    true,
+						},
+						"active": {
+							Type:        schema.TypeBool,
+							Description: "Whether the plan is active",
+							Computed:    true,
+						},
+						"labels": {
+							Type:        schema.TypeList,
+							Description: "The labels associated with the plan",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMorpheusPlansRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	objects, err := listAllObjects(client, morpheus.PlansPath, "servicePlans", map[string]string{})
+	if err != nil {
+		log.Printf("API FAILURE: %s", err)
+		return diag.FromErr(err)
+	}
+
+	filterPaths := map[string]string{
+		"type_code": "provisionType.code",
+		"tenant_id": "account.id",
+	}
+
+	var ids []int
+	var servicePlansOutput []map[string]interface{}
+	for _, object := range objects {
+		match, err := matchesListFilters(d, object, filterPaths)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if !match {
+			continue
+		}
+		ids = append(ids, objectInt(object, "id"))
+
+		row := make(map[string]interface{})
+		row["id"] = objectInt(object, "id")
+		row["name"] = objectString(object, "name")
+		row["code"] = objectString(object, "code")
+		row["description"] = objectString(object, "description")
+		row["type_code"] = objectString(object, "provisionType.code")
+		row["active"] = objectString(object, "active") == "true"
+		row["labels"] = objectStrings(object, "labels")
+		servicePlansOutput = append(servicePlansOutput, row)
+	}
+
+	d.SetId(listDataSourceID(morpheus.PlansPath, ids))
+	d.Set("ids", ids)
+	d.Set("plans", servicePlansOutput)
+	return diags
+}
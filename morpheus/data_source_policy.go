@@ -28,6 +28,11 @@ func dataSourceMorpheusPolicy() *schema.Resource {
 				Optional:      true,
 				ConflictsWith: []string{"id"},
 			},
+			"tenant_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the tenant used to scope the policy lookup",
+				Optional:    true,
+			},
 			"description": {
 				Type:        schema.TypeString,
 				Description: "The description of the policy",
@@ -69,14 +74,28 @@ func dataSourceMorpheusPolicyRead(ctx context.Context, d *schema.ResourceData, m
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
-	name := d.Get("name").(string)
 	id := d.Get("id").(int)
 
-	// lookup by name if we do not have an id yet
+	lookup := dataSourceLookup{
+		Name: "policy",
+		Path: morpheus.PoliciesPath,
+		Key:  "policies",
+		ScopePaths: map[string]string{
+			"tenant_id": "owner.id",
+		},
+	}
+
+	// lookup by the selectors if we do not have an id yet
 	var resp *morpheus.Response
 	var err error
-	if id == 0 && name != "" {
-		resp, err = client.FindPolicyByName(name)
+	if id == 0 && lookup.hasSelector(d) {
+		// resolve the id from the selectors so that ambiguous matches are reported
+		var objectId int64
+		objectId, err = findDataSourceObjectID(client, d, lookup)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		resp, err = client.GetPolicy(objectId, &morpheus.Request{})
 	} else if id != 0 {
 		resp, err = client.GetPolicy(int64(id), &morpheus.Request{})
 	} else {
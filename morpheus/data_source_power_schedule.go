@@ -37,14 +37,25 @@ func dataSourceMorpheusPowerScheduleRead(ctx context.Context, d *schema.Resource
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
-	name := d.Get("name").(string)
 	id := d.Get("id").(int)
 
-	// lookup by name if we do not have an id yet
+	lookup := dataSourceLookup{
+		Name: "power schedule",
+		Path: morpheus.PowerSchedulesPath,
+		Key:  "schedules",
+	}
+
+	// lookup by the selectors if we do not have an id yet
 	var resp *morpheus.Response
 	var err error
-	if id == 0 && name != "" {
-		resp, err = client.FindPowerScheduleByName(name)
+	if id == 0 && lookup.hasSelector(d) {
+		// resolve the id from the selectors so that ambiguous matches are reported
+		var objectId int64
+		objectId, err = findDataSourceObjectID(client, d, lookup)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		resp, err = client.GetPowerSchedule(objectId, &morpheus.Request{})
 	} else if id != 0 {
 		resp, err = client.GetPowerSchedule(int64(id), &morpheus.Request{})
 	} else {
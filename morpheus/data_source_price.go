@@ -17,7 +17,7 @@ func dataSourceMorpheusPrice() *schema.Resource {
 			"id": {
 				Type:          schema.TypeInt,
 				Optional:      true,
-				ConflictsWith: []string{"name"},
+				ConflictsWith: []string{"name", "code"},
 				Computed:      true,
 			},
 			"name": {
@@ -27,9 +27,16 @@ func dataSourceMorpheusPrice() *schema.Resource {
 				ConflictsWith: []string{"id"},
 			},
 			"code": {
-				Type:        schema.TypeString,
-				Description: "The code of the Morpheus price",
-				Computed:    true,
+				Type:          schema.TypeString,
+				Description:   "The code of the Morpheus price",
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"id"},
+			},
+			"tenant_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the tenant used to scope the price lookup",
+				Optional:    true,
 			},
 		},
 	}
@@ -41,18 +48,33 @@ func dataSourceMorpheusPriceRead(ctx context.Context, d *schema.ResourceData, me
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
-	name := d.Get("name").(string)
 	id := d.Get("id").(int)
 
-	// lookup by name if we do not have an id yet
+	lookup := dataSourceLookup{
+		Name: "price",
+		Path: morpheus.PricesPath,
+		Key:  "prices",
+		Code: true,
+		ScopePaths: map[string]string{
+			"tenant_id": "account.id",
+		},
+	}
+
+	// lookup by the selectors if we do not have an id yet
 	var resp *morpheus.Response
 	var err error
-	if id == 0 && name != "" {
-		resp, err = client.FindPriceByName(name)
+	if id == 0 && lookup.hasSelector(d) {
+		// resolve the id from the selectors so that ambiguous matches are reported
+		var objectId int64
+		objectId, err = findDataSourceObjectID(client, d, lookup)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		resp, err = client.GetPrice(objectId, &morpheus.Request{})
 	} else if id != 0 {
 		resp, err = client.GetPrice(int64(id), &morpheus.Request{})
 	} else {
-		return diag.Errorf("Price cannot be read without name or id")
+		return diag.Errorf("Price cannot be read without name, code or id")
 	}
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
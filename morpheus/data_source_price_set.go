@@ -17,7 +17,7 @@ func dataSourceMorpheusPriceSet() *schema.Resource {
 			"id": {
 				Type:          schema.TypeInt,
 				Optional:      true,
-				ConflictsWith: []string{"name"},
+				ConflictsWith: []string{"name", "code"},
 				Computed:      true,
 			},
 			"name": {
@@ -27,9 +27,11 @@ func dataSourceMorpheusPriceSet() *schema.Resource {
 				ConflictsWith: []string{"id"},
 			},
 			"code": {
-				Type:        schema.TypeString,
-				Description: "The code of the Morpheus price set",
-				Computed:    true,
+				Type:          schema.TypeString,
+				Description:   "The code of the Morpheus price set",
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"id"},
 			},
 		},
 	}
@@ -41,18 +43,30 @@ func dataSourceMorpheusPriceSetRead(ctx context.Context, d *schema.ResourceData,
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
-	name := d.Get("name").(string)
 	id := d.Get("id").(int)
 
-	// lookup by name if we do not have an id yet
+	lookup := dataSourceLookup{
+		Name: "price set",
+		Path: morpheus.PriceSetsPath,
+		Key:  "priceSets",
+		Code: true,
+	}
+
+	// lookup by the selectors if we do not have an id yet
 	var resp *morpheus.Response
 	var err error
-	if id == 0 && name != "" {
-		resp, err = client.FindPriceSetByName(name)
+	if id == 0 && lookup.hasSelector(d) {
+		// resolve the id from the selectors so that ambiguous matches are reported
+		var objectId int64
+		objectId, err = findDataSourceObjectID(client, d, lookup)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		resp, err = client.GetPriceSet(objectId, &morpheus.Request{})
 	} else if id != 0 {
 		resp, err = client.GetPriceSet(int64(id), &morpheus.Request{})
 	} else {
-		return diag.Errorf("Price set cannot be read without name or id")
+		return diag.Errorf("Price set cannot be read without name, code or id")
 	}
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
@@ -17,7 +17,7 @@ func dataSourceMorpheusProvisionType() *schema.Resource {
 			"id": {
 				Type:          schema.TypeInt,
 				Optional:      true,
-				ConflictsWith: []string{"name"},
+				ConflictsWith: []string{"name", "code"},
 				Computed:      true,
 			},
 			"name": {
@@ -26,6 +26,12 @@ func dataSourceMorpheusProvisionType() *schema.Resource {
 				Optional:      true,
 				ConflictsWith: []string{"id"},
 			},
+			"code": {
+				Type:          schema.TypeString,
+				Description:   "The code of the provision type",
+				Optional:      true,
+				ConflictsWith: []string{"id"},
+			},
 		},
 	}
 }
@@ -36,18 +42,30 @@ func dataSourceMorpheusProvisionTypeRead(ctx context.Context, d *schema.Resource
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
-	name := d.Get("name").(string)
 	id := d.Get("id").(int)
 
-	// lookup by name if we do not have an id yet
+	lookup := dataSourceLookup{
+		Name: "provision type",
+		Path: morpheus.ProvisionTypesPath,
+		Key:  "provisionTypes",
+		Code: true,
+	}
+
+	// lookup by the selectors if we do not have an id yet
 	var resp *morpheus.Response
 	var err error
-	if id == 0 && name != "" {
-		resp, err = client.FindProvisionTypeByName(name)
+	if id == 0 && lookup.hasSelector(d) {
+		// resolve the id from the selectors so that ambiguous matches are reported
+		var objectId int64
+		objectId, err = findDataSourceObjectID(client, d, lookup)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		resp, err = client.GetProvisionType(objectId, &morpheus.Request{})
 	} else if id != 0 {
 		resp, err = client.GetProvisionType(int64(id), &morpheus.Request{})
 	} else {
-		return diag.Errorf("Provision type cannot be read without name or id")
+		return diag.Errorf("Provision type cannot be read without name, code or id")
 	}
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
@@ -27,6 +27,11 @@ func dataSourceMorpheusStorageBucket() *schema.Resource {
 				Optional:      true,
 				ConflictsWith: []string{"id"},
 			},
+			"tenant_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the tenant used to scope the storage bucket lookup",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -37,14 +42,28 @@ func dataSourceMorpheusStorageBucketRead(ctx context.Context, d *schema.Resource
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
-	name := d.Get("name").(string)
 	id := d.Get("id").(int)
 
-	// lookup by name if we do not have an id yet
+	lookup := dataSourceLookup{
+		Name: "storage bucket",
+		Path: morpheus.StorageBucketsPath,
+		Key:  "storageBuckets",
+		ScopePaths: map[string]string{
+			"tenant_id": "accountId",
+		},
+	}
+
+	// lookup by the selectors if we do not have an id yet
 	var resp *morpheus.Response
 	var err error
-	if id == 0 && name != "" {
-		resp, err = client.FindStorageBucketByName(name)
+	if id == 0 && lookup.hasSelector(d) {
+		// resolve the id from the selectors so that ambiguous matches are reported
+		var objectId int64
+		objectId, err = findDataSourceObjectID(client, d, lookup)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		resp, err = client.GetStorageBucket(objectId, &morpheus.Request{})
 	} else if id != 0 {
 		resp, err = client.GetStorageBucket(int64(id), &morpheus.Request{})
 	} else {
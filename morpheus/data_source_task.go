@@ -17,7 +17,7 @@ func dataSourceMorpheusTask() *schema.Resource {
 			"id": {
 				Type:          schema.TypeInt,
 				Optional:      true,
-				ConflictsWith: []string{"name"},
+				ConflictsWith: []string{"name", "code", "labels"},
 				Computed:      true,
 			},
 			"name": {
@@ -26,6 +26,24 @@ func dataSourceMorpheusTask() *schema.Resource {
 				Optional:      true,
 				ConflictsWith: []string{"id"},
 			},
+			"code": {
+				Type:          schema.TypeString,
+				Description:   "The code of the task",
+				Optional:      true,
+				ConflictsWith: []string{"id"},
+			},
+			"labels": {
+				Type:          schema.TypeSet,
+				Description:   "A list of labels the task must be associated with",
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"id"},
+			},
+			"tenant_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the tenant used to scope the task lookup",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -36,18 +54,34 @@ func dataSourceMorpheusTaskRead(ctx context.Context, d *schema.ResourceData, met
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
-	name := d.Get("name").(string)
 	id := d.Get("id").(int)
 
-	// lookup by name if we do not have an id yet
+	lookup := dataSourceLookup{
+		Name:   "task",
+		Path:   morpheus.TasksPath,
+		Key:    "tasks",
+		Code:   true,
+		Labels: true,
+		ScopePaths: map[string]string{
+			"tenant_id": "accountId",
+		},
+	}
+
+	// lookup by the selectors if we do not have an id yet
 	var resp *morpheus.Response
 	var err error
-	if id == 0 && name != "" {
-		resp, err = client.FindTaskByName(name)
+	if id == 0 && lookup.hasSelector(d) {
+		// resolve the id from the selectors so that ambiguous matches are reported
+		var objectId int64
+		objectId, err = findDataSourceObjectID(client, d, lookup)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		resp, err = client.GetTask(objectId, &morpheus.Request{})
 	} else if id != 0 {
 		resp, err = client.GetTask(int64(id), &morpheus.Request{})
 	} else {
-		return diag.Errorf("Task cannot be read without name or id")
+		return diag.Errorf("Task cannot be read without name, code, labels or id")
 	}
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
@@ -26,6 +26,11 @@ func dataSourceMorpheusUserRole() *schema.Resource {
 				Optional:      true,
 				ConflictsWith: []string{"id"},
 			},
+			"tenant_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the tenant used to scope the role lookup",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -36,15 +41,29 @@ func dataSourceMorpheusUserRoleRead(ctx context.Context, d *schema.ResourceData,
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
-	name := d.Get("name").(string)
 	id := d.Get("id").(int)
 
-	// lookup by name if we do not have an id yet
+	lookup := dataSourceLookup{
+		Name:     "role",
+		Path:     morpheus.RolesPath,
+		Key:      "roles",
+		NamePath: "authority",
+		ScopePaths: map[string]string{
+			"tenant_id": "owner.id",
+		},
+	}
+
+	// lookup by the selectors if we do not have an id yet
 	var resp *morpheus.Response
 	var err error
-	if id == 0 && name != "" {
-		log.Println("Finding the role by name")
-		resp, err = client.FindRoleByName(name)
+	if id == 0 && lookup.hasSelector(d) {
+		// resolve the id from the selectors so that ambiguous matches are reported
+		var objectId int64
+		objectId, err = findDataSourceObjectID(client, d, lookup)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		resp, err = client.GetRole(objectId, &morpheus.Request{})
 	} else if id != 0 {
 		resp, err = client.GetRole(int64(id), &morpheus.Request{})
 	} else {
@@ -27,6 +27,11 @@ func dataSourceMorpheusVDIPool() *schema.Resource {
 				Optional:      true,
 				ConflictsWith: []string{"id"},
 			},
+			"tenant_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the tenant used to scope the VDI pool lookup",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -37,14 +42,28 @@ func dataSourceMorpheusVDIPoolRead(ctx context.Context, d *schema.ResourceData,
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
-	name := d.Get("name").(string)
 	id := d.Get("id").(int)
 
-	// lookup by name if we do not have an id yet
+	lookup := dataSourceLookup{
+		Name: "VDI pool",
+		Path: morpheus.VDIPoolsPath,
+		Key:  "vdiPools",
+		ScopePaths: map[string]string{
+			"tenant_id": "owner.id",
+		},
+	}
+
+	// lookup by the selectors if we do not have an id yet
 	var resp *morpheus.Response
 	var err error
-	if id == 0 && name != "" {
-		resp, err = client.FindVDIPoolByName(name)
+	if id == 0 && lookup.hasSelector(d) {
+		// resolve the id from the selectors so that ambiguous matches are reported
+		var objectId int64
+		objectId, err = findDataSourceObjectID(client, d, lookup)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		resp, err = client.GetVDIPool(objectId, &morpheus.Request{})
 	} else if id != 0 {
 		resp, err = client.GetVDIPool(int64(id), &morpheus.Request{})
 	} else {
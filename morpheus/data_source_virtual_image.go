@@ -17,7 +17,7 @@ func dataSourceMorpheusVirtualImage() *schema.Resource {
 			"id": {
 				Type:          schema.TypeInt,
 				Optional:      true,
-				ConflictsWith: []string{"name"},
+				ConflictsWith: []string{"name", "labels"},
 				Computed:      true,
 			},
 			"name": {
@@ -32,6 +32,18 @@ func dataSourceMorpheusVirtualImage() *schema.Resource {
 				Optional:      true,
 				ConflictsWith: []string{"id"},
 			},
+			"labels": {
+				Type:          schema.TypeSet,
+				Description:   "A list of labels the virtual image must be associated with",
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"id"},
+			},
+			"tenant_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the tenant used to scope the virtual image lookup",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -42,20 +54,37 @@ func dataSourceMorpheusVirtualImageRead(ctx context.Context, d *schema.ResourceD
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
-	name := d.Get("name").(string)
 	id := d.Get("id").(int)
-	imagetype := d.Get("imagetype").(string)
-	// lookup by name if we do not have an id yet
+
+	lookup := dataSourceLookup{
+		Name: "virtual image",
+		Path: morpheus.VirtualImagesPath,
+		Key:  "virtualImages",
+		QueryParams: map[string]string{
+			"filterType": "All",
+		},
+		Labels: true,
+		ScopePaths: map[string]string{
+			"imagetype": "imageType",
+			"tenant_id": "tenant.id",
+		},
+	}
+
+	// lookup by the selectors if we do not have an id yet
 	var resp *morpheus.Response
 	var err error
-	if id == 0 && name != "" && imagetype == "" {
-		resp, err = client.FindVirtualImageByName(name)
-	} else if id == 0 && name != "" && imagetype != "" {
-		resp, err = client.FindVirtualImageByNameAndType(name, imagetype)
+	if id == 0 && lookup.hasSelector(d) {
+		// resolve the id from the selectors so that ambiguous matches are reported
+		var objectId int64
+		objectId, err = findDataSourceObjectID(client, d, lookup)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		resp, err = client.GetVirtualImage(objectId, &morpheus.Request{})
 	} else if id != 0 {
 		resp, err = client.GetVirtualImage(int64(id), &morpheus.Request{})
 	} else {
-		return diag.Errorf("Virtual image cannot be read without name or id")
+		return diag.Errorf("Virtual image cannot be read without name, labels or id")
 	}
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
@@ -17,7 +17,7 @@ func dataSourceMorpheusWorkflow() *schema.Resource {
 			"id": {
 				Type:          schema.TypeInt,
 				Optional:      true,
-				ConflictsWith: []string{"name"},
+				ConflictsWith: []string{"name", "labels"},
 				Computed:      true,
 			},
 			"name": {
@@ -26,6 +26,18 @@ func dataSourceMorpheusWorkflow() *schema.Resource {
 				Optional:      true,
 				ConflictsWith: []string{"id"},
 			},
+			"labels": {
+				Type:          schema.TypeSet,
+				Description:   "A list of labels the workflow must be associated with",
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"id"},
+			},
+			"tenant_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the tenant used to scope the workflow lookup",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -37,17 +49,32 @@ func dataSourceMorpheusWorkflowRead(ctx context.Context, d *schema.ResourceData,
 	var diags diag.Diagnostics
 
 	id := d.Get("id").(int)
-	name := d.Get("name").(string)
 
-	// lookup by name if we do not have an id yet
+	lookup := dataSourceLookup{
+		Name:   "workflow",
+		Path:   morpheus.TaskSetsPath,
+		Key:    "taskSets",
+		Labels: true,
+		ScopePaths: map[string]string{
+			"tenant_id": "accountId",
+		},
+	}
+
+	// lookup by the selectors if we do not have an id yet
 	var resp *morpheus.Response
 	var err error
-	if id == 0 && name != "" {
-		resp, err = client.FindTaskSetByName(name)
+	if id == 0 && lookup.hasSelector(d) {
+		// resolve the id from the selectors so that ambiguous matches are reported
+		var objectId int64
+		objectId, err = findDataSourceObjectID(client, d, lookup)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		resp, err = client.GetTaskSet(objectId, &morpheus.Request{})
 	} else if id != 0 {
 		resp, err = client.GetTaskSet(int64(id), &morpheus.Request{})
 	} else {
-		return diag.Errorf("Workflow cannot be read without name or id")
+		return diag.Errorf("Workflow cannot be read without name, labels or id")
 	}
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
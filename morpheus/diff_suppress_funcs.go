@@ -2,6 +2,8 @@ package morpheus
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"log"
 	"strings"
@@ -42,3 +44,15 @@ func supressInputHereDOC(k, old, new string, d *schema.ResourceData) bool {
 		return false
 	}
 }
+
+// suppressHashedMapValueDiffs suppresses the diff of a sensitive map value
+// when the state holds the sha256 hash of the configured value
+func suppressHashedMapValueDiffs(k, old, new string, d *schema.ResourceData) bool {
+	if strings.HasSuffix(k, ".%") {
+		return old == new
+	}
+	h := sha256.New()
+	h.Write([]byte(new))
+	sha256_hash := hex.EncodeToString(h.Sum(nil))
+	return strings.EqualFold(old, sha256_hash)
+}
@@ -0,0 +1,158 @@
+package morpheus
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"os"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// logoImage describes the attributes of a logo image of a resource, the
+// logo is provided either as a local file path or as base64 encoded
+// content and the checksum of the bytes is tracked in the state so a
+// change to the image is uploaded even when the path does not change
+type logoImage struct {
+	parameterName string
+	name          string
+	path          string
+	contentBase64 string
+	checksum      string
+}
+
+var (
+	// logoImageAttributes are the attributes of the logo of the instance
+	// catalog item and instance type resources
+	logoImageAttributes = logoImage{
+		parameterName: "logo",
+		name:          "image_name",
+		path:          "image_path",
+		contentBase64: "logo_content_base64",
+		checksum:      "logo_checksum",
+	}
+	// logoAttributes are the attributes of the logo of the resources that
+	// also support a dark mode logo
+	logoAttributes = logoImage{
+		parameterName: "logo",
+		name:          "logo_image_name",
+		path:          "logo_image_path",
+		contentBase64: "logo_content_base64",
+		checksum:      "logo_checksum",
+	}
+	// darkLogoAttributes are the attributes of the dark mode logo
+	darkLogoAttributes = logoImage{
+		parameterName: "darkLogo",
+		name:          "dark_logo_image_name",
+		path:          "dark_logo_image_path",
+		contentBase64: "dark_logo_content_base64",
+		checksum:      "dark_logo_checksum",
+	}
+)
+
+// content returns the bytes of the configured logo image, nil is returned
+// when neither the path nor the base64 content is configured
+func (logo logoImage) content(path string, contentBase64 string) ([]byte, error) {
+	if contentBase64 != "" {
+		data, err := base64.StdEncoding.DecodeString(contentBase64)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not valid base64: %s", logo.contentBase64, err)
+		}
+		return data, nil
+	}
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the %s %s: %s", logo.path, path, err)
+		}
+		return data, nil
+	}
+	return nil, nil
+}
+
+// configuredPath returns the path of the logo image from the configuration,
+// the path is computed on some resources so the prior value is ignored.
+// false is returned when the configured path is not known yet
+func (logo logoImage) configuredPath(config cty.Value) (string, bool) {
+	if config.IsNull() || !config.IsKnown() {
+		return "", false
+	}
+	path := config.GetAttr(logo.path)
+	if !path.IsKnown() {
+		return "", false
+	}
+	if path.IsNull() {
+		return "", true
+	}
+	return path.AsString(), true
+}
+
+// logoImageChecksum returns the SHA256 checksum of the logo image bytes
+func logoImageChecksum(data []byte) string {
+	if data == nil {
+		return ""
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// setLogoImageChecksums returns a CustomizeDiff function that computes the
+// checksum of each logo image during the plan, a change in the bytes of
+// the image changes the checksum which triggers the upload of the logo
+func setLogoImageChecksums(logos ...logoImage) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		for _, logo := range logos {
+			path, known := logo.configuredPath(d.GetRawConfig())
+			if !known || !d.NewValueKnown(logo.contentBase64) {
+				if err := d.SetNewComputed(logo.checksum); err != nil {
+					return err
+				}
+				continue
+			}
+			data, err := logo.content(path, d.Get(logo.contentBase64).(string))
+			if err != nil {
+				return err
+			}
+			if checksum := logoImageChecksum(data); checksum != d.Get(logo.checksum).(string) {
+				if err := d.SetNew(logo.checksum, checksum); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+}
+
+// logoImagePayloads returns the file payloads of the logo images, during an
+// update only the logo images that changed are included
+func logoImagePayloads(d *schema.ResourceData, logos ...logoImage) ([]*morpheus.FilePayload, error) {
+	var filePayloads []*morpheus.FilePayload
+	for _, logo := range logos {
+		if !d.IsNewResource() && !d.HasChanges(logo.name, logo.path, logo.contentBase64, logo.checksum) {
+			continue
+		}
+		if d.Get(logo.name).(string) == "" {
+			continue
+		}
+		path, _ := logo.configuredPath(d.GetRawConfig())
+		data, err := logo.content(path, d.Get(logo.contentBase64).(string))
+		if err != nil {
+			return nil, err
+		}
+		// The checksum is unknown during the plan when the content is computed
+		d.Set(logo.checksum, logoImageChecksum(data))
+		if data == nil {
+			continue
+		}
+		filePayloads = append(filePayloads, &morpheus.FilePayload{
+			ParameterName: logo.parameterName,
+			FileName:      d.Get(logo.name).(string),
+			FileContent:   data,
+		})
+	}
+	return filePayloads, nil
+}
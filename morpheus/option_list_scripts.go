@@ -0,0 +1,113 @@
+package morpheus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/dop251/goja"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// optionListScriptTimeout bounds the evaluation of the translation script
+// against the test input so a runaway loop does not hang the plan
+const optionListScriptTimeout = 5 * time.Second
+
+// validateOptionListScript parses the option list script with an embedded
+// javascript engine so syntax errors are reported during the plan instead
+// of when the option list is first loaded by a form
+func validateOptionListScript(i interface{}, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	script, ok := i.(string)
+	if !ok || script == "" {
+		return diags
+	}
+	if _, err := goja.Compile("script", script, false); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Invalid option list script",
+			Detail:        fmt.Sprintf("The script could not be parsed: %s", err),
+			AttributePath: path,
+		})
+	}
+	return diags
+}
+
+// evaluateOptionListTranslationScript runs the translation script with the
+// test input as the data variable, the same way the appliance does, and
+// returns the name and value pairs pushed to the results variable
+func evaluateOptionListTranslationScript(script string, testInput string) ([]interface{}, error) {
+	var data interface{}
+	if err := json.Unmarshal([]byte(testInput), &data); err != nil {
+		return nil, fmt.Errorf("test_input is not valid json: %s", err)
+	}
+
+	vm := goja.New()
+	vm.Set("data", data)
+	vm.Set("input", map[string]interface{}{})
+	vm.Set("results", vm.NewArray())
+
+	timer := time.AfterFunc(optionListScriptTimeout, func() {
+		vm.Interrupt(fmt.Sprintf("the translation script did not complete within %s", optionListScriptTimeout))
+	})
+	defer timer.Stop()
+
+	if _, err := vm.RunString(script); err != nil {
+		return nil, fmt.Errorf("the translation script failed with the test_input: %s", err)
+	}
+
+	exported, ok := vm.Get("results").Export().([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("the translation script must set results to an array")
+	}
+	results := make([]interface{}, 0, len(exported))
+	for index, v := range exported {
+		option, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("the translation script result at index %d is not an object with a name and value", index)
+		}
+		result := make(map[string]interface{})
+		result["name"] = optionListScriptValue(option["name"])
+		result["value"] = optionListScriptValue(option["value"])
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// optionListScriptValue formats an option name or value as a string,
+// numbers are formatted without an exponent so large ids are preserved
+func optionListScriptValue(v interface{}) string {
+	switch value := v.(type) {
+	case nil:
+		return ""
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	default:
+		return fmt.Sprintf("%v", value)
+	}
+}
+
+// setOptionListTestOutput evaluates the translation script against the
+// test input during the plan and exposes the resulting options
+func setOptionListTestOutput(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("test_input") || !d.NewValueKnown("translation_script") {
+		return d.SetNewComputed("test_output")
+	}
+	testInput := d.Get("test_input").(string)
+	if testInput == "" {
+		if len(d.Get("test_output").([]interface{})) > 0 {
+			return d.SetNew("test_output", []interface{}{})
+		}
+		return nil
+	}
+	results, err := evaluateOptionListTranslationScript(d.Get("translation_script").(string), testInput)
+	if err != nil {
+		return err
+	}
+	return d.SetNew("test_output", results)
+}
@@ -65,12 +65,16 @@ func Provider() *schema.Provider {
 			"morpheus_aws_cloud":                             resourceAWSCloud(),
 			"morpheus_azure_cloud":                           resourceAzureCloud(),
 			"morpheus_backup_creation_policy":                resourceBackupCreationPolicy(),
+			"morpheus_backup_integration":                    resourceBackupIntegration(),
+			"morpheus_backup_job":                            resourceBackupJob(),
 			"morpheus_backup_setting":                        resourceBackupSetting(),
 			"morpheus_boot_script":                           resourceBootScript(),
 			"morpheus_budget_policy":                         resourceBudgetPolicy(),
 			"morpheus_checkbox_option_type":                  resourceCheckboxOptionType(),
 			"morpheus_cloud_formation_app_blueprint":         resourceCloudFormationAppBlueprint(),
 			"morpheus_cloud_formation_spec_template":         resourceCloudFormationSpecTemplate(),
+			"morpheus_cloud_network_configuration":           resourceCloudNetworkConfiguration(),
+			"morpheus_cloud_resource_pool_configuration":     resourceCloudResourcePoolConfiguration(),
 			"morpheus_cluster_layout":                        resourceClusterLayout(),
 			"morpheus_cluster_package":                       resourceClusterPackage(),
 			"morpheus_cluster_resource_name_policy":          resourceClusterResourceNamePolicy(),
@@ -85,7 +89,10 @@ func Provider() *schema.Provider {
 			"morpheus_email_task":                            resourceEmailTask(),
 			"morpheus_environment":                           resourceEnvironment(),
 			"morpheus_execute_schedule":                      resourceExecuteSchedule(),
+			"morpheus_file_share":                            resourceFileShare(),
 			"morpheus_file_template":                         resourceFileTemplate(),
+			"morpheus_form":                                  resourceForm(),
+			"morpheus_gcp_cloud":                             resourceGCPCloud(),
 			"morpheus_git_integration":                       resourceGitIntegration(),
 			"morpheus_groovy_script_task":                    resourceGroovyScriptTask(),
 			"morpheus_group":                                 resourceMorpheusGroup(),
@@ -94,12 +101,16 @@ func Provider() *schema.Provider {
 			"morpheus_helm_spec_template":                    resourceHelmSpecTemplate(),
 			"morpheus_hidden_option_type":                    resourceHiddenOptionType(),
 			"morpheus_hostname_policy":                       resourceHostNamePolicy(),
+			"morpheus_infoblox_integration":                  resourceInfobloxIntegration(),
+			"morpheus_instance_backup":                       resourceInstanceBackup(),
 			"morpheus_instance_catalog_item":                 resourceInstanceCatalogItem(),
 			"morpheus_instance_layout":                       resourceInstanceLayout(),
 			"morpheus_instance_name_policy":                  resourceInstanceNamePolicy(),
 			"morpheus_instance_type":                         resourceInstanceType(),
+			"morpheus_integration":                           resourceIntegration(),
 			"morpheus_ipv4_ip_pool":                          resourceIPv4IPPool(),
 			"morpheus_javascript_task":                       resourceJavaScriptTask(),
+			"morpheus_kubernetes_cloud":                      resourceKubernetesCloud(),
 			"morpheus_library_script_task":                   resourceLibraryScriptTask(),
 			"morpheus_library_template_task":                 resourceLibraryTemplateTask(),
 			"morpheus_license":                               resourceLicense(),
@@ -113,16 +124,23 @@ func Provider() *schema.Provider {
 			"morpheus_max_memory_policy":                     resourceMaxMemoryPolicy(),
 			"morpheus_max_storage_policy":                    resourceMaxStoragePolicy(),
 			"morpheus_max_vms_policy":                        resourceMaxVmsPolicy(),
+			"morpheus_microsoft_dns_integration":             resourceMicrosoftDNSIntegration(),
 			"morpheus_monitoring_setting":                    resourceMonitoringSetting(),
 			"morpheus_motd_policy":                           resourceMotdPolicy(),
 			"morpheus_nested_workflow_task":                  resourceNestedWorkflowTask(),
 			"morpheus_network_domain":                        resourceNetworkDomain(),
+			"morpheus_network_domain_record":                 resourceNetworkDomainRecord(),
+			"morpheus_network_proxy":                         resourceNetworkProxy(),
 			"morpheus_network_quota_policy":                  resourceNetworkQuotaPolicy(),
+			"morpheus_network_router":                        resourceNetworkRouter(),
 			"morpheus_node_type":                             resourceNodeType(),
 			"morpheus_number_option_type":                    resourceNumberOptionType(),
+			"morpheus_nutanix_prism_cloud":                   resourceNutanixPrismCloud(),
+			"morpheus_openstack_cloud":                       resourceOpenStackCloud(),
 			"morpheus_operational_workflow":                  resourceOperationalWorkflow(),
 			"morpheus_password_option_type":                  resourcePasswordOptionType(),
 			"morpheus_power_schedule_policy":                 resourcePowerSchedulePolicy(),
+			"morpheus_powerdns_integration":                  resourcePowerDNSIntegration(),
 			"morpheus_powershell_script_task":                resourcePowerShellScriptTask(),
 			"morpheus_preseed_script":                        resourcePreseedScript(),
 			"morpheus_price_set":                             resourcePriceSet(),
@@ -133,9 +151,11 @@ func Provider() *schema.Provider {
 			"morpheus_puppet_integration":                    resourcePuppetIntegration(),
 			"morpheus_python_script_task":                    resourcePythonScriptTask(),
 			"morpheus_radio_list_option_type":                resourceRadioListOptionType(),
+			"morpheus_resource_pool":                         resourceResourcePool(),
 			"morpheus_resource_pool_group":                   resourceResourcePoolGroup(),
 			"morpheus_rest_option_list":                      resourceRestOptionList(),
 			"morpheus_restart_task":                          resourceRestartTask(),
+			"morpheus_route53_integration":                   resourceRoute53Integration(),
 			"morpheus_router_quota_policy":                   resourceRouterQuotaPolicy(),
 			"morpheus_ruby_script_task":                      resourceRubyScriptTask(),
 			"morpheus_saml_identity_source":                  resourceSAMLIdentitySource(),
@@ -147,7 +167,12 @@ func Provider() *schema.Provider {
 			"morpheus_servicenow_integration":                resourceServiceNowIntegration(),
 			"morpheus_shell_script_task":                     resourceShellScriptTask(),
 			"morpheus_standard_cloud":                        resourceStandardCloud(),
+			"morpheus_storage_bucket":                        resourceStorageBucket(),
+			"morpheus_storage_server":                        resourceStorageServer(),
+			"morpheus_storage_volume":                        resourceStorageVolume(),
 			"morpheus_tag_policy":                            resourceTagPolicy(),
+			"morpheus_task":                                  resourceTask(),
+			"morpheus_task_execution":                        resourceTaskExecution(),
 			"morpheus_task_job":                              resourceTaskJob(),
 			"morpheus_tenant_role":                           resourceTenantRole(),
 			"morpheus_tenant":                                resourceTenant(),
@@ -161,6 +186,8 @@ func Provider() *schema.Provider {
 			"morpheus_user":                                  resourceMorpheusUser(),
 			"morpheus_user_group":                            resourceUserGroup(),
 			"morpheus_user_role":                             resourceUserRole(),
+			"morpheus_vault_integration":                     resourceVaultIntegration(),
+			"morpheus_virtual_image":                         resourceVirtualImage(),
 			"morpheus_vro_integration":                       resourceVrealizeOrchestratorIntegration(),
 			"morpheus_vro_task":                              resourceVrealizeOrchestratorTask(),
 			"morpheus_vsphere_cloud_datastore_configuration": resourceVSphereCloudDatastoreConfiguration(),
@@ -168,6 +195,7 @@ func Provider() *schema.Provider {
 			"morpheus_vsphere_instance":                      resourceVsphereInstance(),
 			"morpheus_wiki_page":                             resourceWikiPage(),
 			"morpheus_workflow_catalog_item":                 resourceWorkflowCatalogItem(),
+			"morpheus_workflow_execution":                    resourceWorkflowExecution(),
 			"morpheus_workflow_job":                          resourceWorkflowJob(),
 			"morpheus_workflow_policy":                       resourceWorkflowPolicy(),
 			"morpheus_write_attributes_task":                 resourceWriteAttributesTask(),
@@ -179,6 +207,7 @@ func Provider() *schema.Provider {
 			"morpheus_budget":                     dataSourceMorpheusBudget(),
 			"morpheus_catalog_item_type":          dataSourceMorpheusCatalogItemType(),
 			"morpheus_cloud":                      dataSourceMorpheusCloud(),
+			"morpheus_clouds":                     dataSourceMorpheusClouds(),
 			"morpheus_cluster_type":               dataSourceMorpheusClusterType(),
 			"morpheus_contact":                    dataSourceMorpheusContact(),
 			"morpheus_credential":                 dataSourceMorpheusCredential(),
@@ -189,19 +218,26 @@ func Provider() *schema.Provider {
 			"morpheus_file_template":              dataSourceMorpheusFileTemplate(),
 			"morpheus_git_integration":            dataSourceMorpheusGitIntegration(),
 			"morpheus_group":                      dataSourceMorpheusGroup(),
+			"morpheus_groups":                     dataSourceMorpheusGroups(),
 			"morpheus_instance_layout":            dataSourceMorpheusInstanceLayout(),
 			"morpheus_instance_type":              dataSourceMorpheusInstanceType(),
+			"morpheus_instance_types":             dataSourceMorpheusInstanceTypes(),
+			"morpheus_instances":                  dataSourceMorpheusInstances(),
 			"morpheus_integration":                dataSourceMorpheusIntegration(),
 			"morpheus_job":                        dataSourceMorpheusJob(),
 			"morpheus_key_pair":                   dataSourceMorpheusKeyPair(),
 			"morpheus_network":                    dataSourceMorpheusNetwork(),
 			"morpheus_network_group":              dataSourceMorpheusNetworkGroup(),
 			"morpheus_network_subnet":             dataSourceMorpheusNetworkSubnet(),
+			"morpheus_networks":                   dataSourceMorpheusNetworks(),
 			"morpheus_node_type":                  dataSourceMorpheusNodeType(),
 			"morpheus_option_list":                dataSourceMorpheusOptionList(),
+			"morpheus_option_list_preview":        dataSourceMorpheusOptionListPreview(),
 			"morpheus_option_type":                dataSourceMorpheusOptionType(),
+			"morpheus_option_types":               dataSourceMorpheusOptionTypes(),
 			"morpheus_permission_set":             dataSourceMorpheusPermissionSet(),
 			"morpheus_plan":                       dataSourceMorpheusPlan(),
+			"morpheus_plans":                      dataSourceMorpheusPlans(),
 			"morpheus_policy":                     dataSourceMorpheusPolicy(),
 			"morpheus_power_schedule":             dataSourceMorpheusPowerSchedule(),
 			"morpheus_price_set":                  dataSourceMorpheusPriceSet(),
@@ -214,6 +250,7 @@ func Provider() *schema.Provider {
 			"morpheus_spec_template":              dataSourceMorpheusSpecTemplate(),
 			"morpheus_storage_bucket":             dataSourceMorpheusStorageBucket(),
 			"morpheus_task":                       dataSourceMorpheusTask(),
+			"morpheus_tasks":                      dataSourceMorpheusTasks(),
 			"morpheus_tenant_role":                dataSourceMorpheusTenantRole(),
 			"morpheus_tenant":                     dataSourceMorpheusTenant(),
 			"morpheus_user_group":                 dataSourceMorpheusUserGroup(),
@@ -222,6 +259,7 @@ func Provider() *schema.Provider {
 			"morpheus_virtual_image":              dataSourceMorpheusVirtualImage(),
 			"morpheus_vro_workflow":               dataSourceMorpheusVrealizeOrchestratorWorkflow(),
 			"morpheus_workflow":                   dataSourceMorpheusWorkflow(),
+			"morpheus_workflows":                  dataSourceMorpheusWorkflows(),
 		},
 		ConfigureContextFunc: providerConfigure,
 	}
@@ -18,6 +18,7 @@ func resourceApiOptionList() *schema.Resource {
 		ReadContext:   resourceApiOptionListRead,
 		UpdateContext: resourceApiOptionListUpdate,
 		DeleteContext: resourceApiOptionListDelete,
+		CustomizeDiff: setOptionListTestOutput,
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -58,8 +59,9 @@ func resourceApiOptionList() *schema.Resource {
 			},
 			"translation_script": {
 				Type:             schema.TypeString,
-				Description:      "A js script to translate the result data object into an Array containing objects with properties 'name’ and 'value’.",
+				Description:      "A js script to translate the result data object into an Array containing objects with properties 'name’ and 'value’. The script is parsed during the plan to report syntax errors",
 				DiffSuppressFunc: supressOptionListScripts,
+				ValidateDiagFunc: validateOptionListScript,
 				Optional:         true,
 				Computed:         true,
 			},
@@ -67,9 +69,35 @@ func resourceApiOptionList() *schema.Resource {
 				Type:             schema.TypeString,
 				Description:      "A js script to manipulate the request payload.",
 				DiffSuppressFunc: supressOptionListScripts,
+				ValidateDiagFunc: validateOptionListScript,
 				Optional:         true,
 				Computed:         true,
 			},
+			"test_input": {
+				Type:         schema.TypeString,
+				Description:  "A sample json payload that is run through the translation script during the plan, the resulting options are exposed as the test_output",
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"test_output": {
+				Type:        schema.TypeList,
+				Description: "The options returned by the translation script for the test_input",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The name of the option",
+							Computed:    true,
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Description: "The value of the option",
+							Computed:    true,
+						},
+					},
+				},
+			},
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -2,7 +2,6 @@ package morpheus
 
 import (
 	"context"
-	"os"
 	"strings"
 
 	"log"
@@ -20,6 +19,7 @@ func resourceAppBlueprintCatalogItem() *schema.Resource {
 		ReadContext:   resourceAppBlueprintCatalogItemRead,
 		UpdateContext: resourceAppBlueprintCatalogItemUpdate,
 		DeleteContext: resourceAppBlueprintCatalogItemDelete,
+		CustomizeDiff: setLogoImageChecksums(logoAttributes, darkLogoAttributes),
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -82,6 +82,12 @@ func resourceAppBlueprintCatalogItem() *schema.Resource {
 				Description: "The app spec associated with the app blueprint catalog item",
 				Required:    true,
 			},
+			"form_id": {
+				Type:          schema.TypeInt,
+				Description:   "The ID of the form associated with the app blueprint catalog item, used instead of the option types",
+				Optional:      true,
+				ConflictsWith: []string{"option_type_ids"},
+			},
 			"option_type_ids": {
 				Type:        schema.TypeList,
 				Description: "The list of option type ids associated with the app blueprint catalog item",
@@ -116,6 +122,30 @@ func resourceAppBlueprintCatalogItem() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 			},
+			"logo_content_base64": {
+				Type:          schema.TypeString,
+				Description:   "The base64 encoded content of the app blueprint catalog item logo image, used instead of the logo_image_path so the image does not need to exist on the machine running terraform",
+				Optional:      true,
+				ConflictsWith: []string{"logo_image_path"},
+				RequiredWith:  []string{"logo_image_name"},
+			},
+			"logo_checksum": {
+				Type:        schema.TypeString,
+				Description: "The SHA256 checksum of the app blueprint catalog item logo image, the logo is uploaded again when the content of the image changes",
+				Computed:    true,
+			},
+			"dark_logo_content_base64": {
+				Type:          schema.TypeString,
+				Description:   "The base64 encoded content of the app blueprint catalog item dark mode logo image, used instead of the dark_logo_image_path so the image does not need to exist on the machine running terraform",
+				Optional:      true,
+				ConflictsWith: []string{"dark_logo_image_path"},
+				RequiredWith:  []string{"dark_logo_image_name"},
+			},
+			"dark_logo_checksum": {
+				Type:        schema.TypeString,
+				Description: "The SHA256 checksum of the app blueprint catalog item dark mode logo image, the logo is uploaded again when the content of the image changes",
+				Computed:    true,
+			},
 			"visibility": {
 				Type:         schema.TypeString,
 				Description:  "The visibility of the app blueprint catalog item (public or private)",
@@ -145,6 +175,14 @@ func resourceAppBlueprintCatalogItemCreate(ctx context.Context, d *schema.Resour
 	catalogItem["type"] = "blueprint"
 	catalogItem["iconPath"] = "custom"
 	catalogItem["optionTypes"] = d.Get("option_type_ids")
+	if formID, ok := d.GetOk("form_id"); ok {
+		catalogItem["formType"] = "form"
+		catalogItem["form"] = map[string]interface{}{
+			"id": formID.(int),
+		}
+	} else {
+		catalogItem["formType"] = "optionTypes"
+	}
 	catalogItem["content"] = d.Get("content").(string)
 	catalogItem["visibility"] = d.Get("visibility").(string)
 
@@ -177,40 +215,15 @@ func resourceAppBlueprintCatalogItemCreate(ctx context.Context, d *schema.Resour
 
 	result := resp.Result.(*morpheus.CreateCatalogItemResult)
 	catalogItemResult := result.CatalogItem
-	var filePayloads []*morpheus.FilePayload
-
-	if d.Get("logo_image_path") != "" && d.Get("logo_image_name") != "" {
-		data, err := os.ReadFile(d.Get("logo_image_path").(string))
-		if err != nil {
-			return diag.FromErr(err)
-		}
-
-		filePayload := &morpheus.FilePayload{
-			ParameterName: "logo",
-			FileName:      d.Get("logo_image_name").(string),
-			FileContent:   data,
-		}
-		filePayloads = append(filePayloads, filePayload)
-	}
-	if d.Get("dark_logo_image_path") != "" && d.Get("dark_logo_image_name") != "" {
-		darkLogoData, err := os.ReadFile(d.Get("dark_logo_image_path").(string))
-		if err != nil {
-			return diag.FromErr(err)
-		}
-
-		darkLogoPayload := &morpheus.FilePayload{
-			ParameterName: "darkLogo",
-			FileName:      d.Get("dark_logo_image_name").(string),
-			FileContent:   darkLogoData,
-		}
-		filePayloads = append(filePayloads, darkLogoPayload)
+	filePayloads, err := logoImagePayloads(d, logoAttributes, darkLogoAttributes)
+	if err != nil {
+		return diag.FromErr(err)
 	}
-
-	// any payloads
 	if len(filePayloads) > 0 {
 		response, err := client.UpdateCatalogItemLogo(catalogItemResult.ID, filePayloads, &morpheus.Request{})
 		if err != nil {
 			log.Printf("API FAILURE: %s - %s", response, err)
+			return diag.FromErr(err)
 		}
 		log.Printf("API RESPONSE: %s", response)
 	}
@@ -274,6 +287,11 @@ func resourceAppBlueprintCatalogItemRead(ctx context.Context, d *schema.Resource
 		}
 	}
 	d.Set("option_type_ids", optionTypes)
+	if catalogItem.FormType == "form" {
+		d.Set("form_id", catalogItem.Form.ID)
+	} else {
+		d.Set("form_id", 0)
+	}
 	d.Set("app_spec", catalogItem.AppSpec)
 	d.Set("content", catalogItem.Content)
 	d.Set("blueprint_id", catalogItem.Blueprint.ID)
@@ -301,6 +319,14 @@ func resourceAppBlueprintCatalogItemUpdate(ctx context.Context, d *schema.Resour
 	catalogItem["type"] = "blueprint"
 	catalogItem["iconPath"] = "custom"
 	catalogItem["optionTypes"] = d.Get("option_type_ids")
+	if formID, ok := d.GetOk("form_id"); ok {
+		catalogItem["formType"] = "form"
+		catalogItem["form"] = map[string]interface{}{
+			"id": formID.(int),
+		}
+	} else {
+		catalogItem["formType"] = "optionTypes"
+	}
 	catalogItem["content"] = d.Get("content").(string)
 	catalogItem["visibility"] = d.Get("visibility").(string)
 
@@ -333,40 +359,15 @@ func resourceAppBlueprintCatalogItemUpdate(ctx context.Context, d *schema.Resour
 	result := resp.Result.(*morpheus.UpdateCatalogItemResult)
 	catalogItemResult := result.CatalogItem
 
-	var filePayloads []*morpheus.FilePayload
-
-	if d.Get("logo_image_path") != "" && d.Get("logo_image_name") != "" {
-		data, err := os.ReadFile(d.Get("logo_image_path").(string))
-		if err != nil {
-			return diag.FromErr(err)
-		}
-
-		filePayload := &morpheus.FilePayload{
-			ParameterName: "logo",
-			FileName:      d.Get("logo_image_name").(string),
-			FileContent:   data,
-		}
-		filePayloads = append(filePayloads, filePayload)
-	}
-
-	if d.Get("dark_logo_image_path") != "" && d.Get("dark_logo_image_name") != "" {
-		darkLogoData, err := os.ReadFile(d.Get("dark_logo_image_path").(string))
-		if err != nil {
-			return diag.FromErr(err)
-		}
-
-		darkLogoPayload := &morpheus.FilePayload{
-			ParameterName: "darkLogo",
-			FileName:      d.Get("dark_logo_image_name").(string),
-			FileContent:   darkLogoData,
-		}
-		filePayloads = append(filePayloads, darkLogoPayload)
+	filePayloads, err := logoImagePayloads(d, logoAttributes, darkLogoAttributes)
+	if err != nil {
+		return diag.FromErr(err)
 	}
-
 	if len(filePayloads) > 0 {
 		response, err := client.UpdateCatalogItemLogo(catalogItemResult.ID, filePayloads, &morpheus.Request{})
 		if err != nil {
 			log.Printf("API FAILURE: %s - %s", response, err)
+			return diag.FromErr(err)
 		}
 		log.Printf("API RESPONSE: %s", response)
 	}
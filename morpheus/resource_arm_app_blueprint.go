@@ -20,6 +20,7 @@ func resourceArmAppBlueprint() *schema.Resource {
 		ReadContext:   resourceArmAppBlueprintRead,
 		UpdateContext: resourceArmAppBlueprintUpdate,
 		DeleteContext: resourceArmAppBlueprintDelete,
+		CustomizeDiff: validateTemplateContent("blueprint_content", map[string]templateContentValidator{"json": armTemplateContent}),
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -68,7 +69,7 @@ func resourceArmAppBlueprint() *schema.Resource {
 			},
 			"blueprint_content": {
 				Type:        schema.TypeString,
-				Description: "The content of the arm app blueprint. Used when the json source type is specified",
+				Description: "The content of the arm app blueprint. Used when the json source type is specified, the content must be an ARM template with the $schema and resources properties",
 				Optional:    true,
 				StateFunc: func(val interface{}) string {
 					return strings.TrimSuffix(val.(string), "\n")
@@ -21,6 +21,7 @@ func resourceArmSpecTemplate() *schema.Resource {
 		ReadContext:   resourceArmSpecTemplateRead,
 		UpdateContext: resourceArmSpecTemplateUpdate,
 		DeleteContext: resourceArmSpecTemplateDelete,
+		CustomizeDiff: validateTemplateContent("spec_content", map[string]templateContentValidator{"local": armTemplateContent}),
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -41,7 +42,7 @@ func resourceArmSpecTemplate() *schema.Resource {
 			},
 			"spec_content": {
 				Type:        schema.TypeString,
-				Description: "The content of the arm spec template. Used when the local source type is specified",
+				Description: "The content of the arm spec template. Used when the local source type is specified, the content must be an ARM template with the $schema and resources properties",
 				Optional:    true,
 				StateFunc: func(val interface{}) string {
 					return strings.TrimSuffix(val.(string), "\n")
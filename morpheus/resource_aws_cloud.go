@@ -69,6 +69,16 @@ func resourceAWSCloud() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 			},
+			"api_proxy_id": {
+				Description: "The id of the network proxy used to communicate with the cloud API",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"provisioning_proxy_id": {
+				Description: "The id of the network proxy used by instances provisioned into the cloud",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
 			"enabled": {
 				Description: "Determines whether the cloud is active or not",
 				Type:        schema.TypeBool,
@@ -182,6 +192,8 @@ func resourceAWSCloud() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 			},
+			"wait_for_initial_sync": cloudWaitForInitialSyncSchema(),
+			"refresh_triggers":      cloudRefreshTriggersSchema(),
 		},
 	}
 }
@@ -260,6 +272,7 @@ func resourceAWSCloudCreate(ctx context.Context, d *schema.ResourceData, meta in
 	cloudType := make(map[string]interface{})
 	cloudType["code"] = "amazon"
 	cloud["zoneType"] = cloudType
+	cloudNetworkProxyPayload(d, cloud)
 
 	payload := map[string]interface{}{
 		"zone": cloud,
@@ -275,6 +288,8 @@ func resourceAWSCloudCreate(ctx context.Context, d *schema.ResourceData, meta in
 	log.Printf("API RESPONSE: %s", resp)
 	result := resp.Result.(*morpheus.CreateCloudResult)
 	cloudOutput := result.Cloud
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(cloudOutput.ID))
 
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"initializing", "syncing"},
@@ -288,7 +303,7 @@ func resourceAWSCloudCreate(ctx context.Context, d *schema.ResourceData, meta in
 			cloudStatus := result.Cloud
 			return result, cloudStatus.Status, nil
 		},
-		Timeout:      1 * time.Hour,
+		Timeout:      d.Timeout(schema.TimeoutCreate),
 		MinTimeout:   1 * time.Minute,
 		Delay:        1 * time.Minute,
 		PollInterval: 1 * time.Minute,
@@ -300,8 +315,13 @@ func resourceAWSCloudCreate(ctx context.Context, d *schema.ResourceData, meta in
 		return diag.Errorf("error creating cloud: %s", err)
 	}
 
-	// Successfully created resource, now set id
-	d.SetId(int64ToString(cloudOutput.ID))
+	if d.Get("wait_for_initial_sync").(bool) {
+		err = waitForCloudSync(ctx, client, cloudOutput.ID, "", d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.Errorf("error waiting for initial cloud sync: %s", err)
+		}
+	}
+
 	resourceAWSCloudRead(ctx, d, meta)
 	return diags
 }
@@ -378,6 +398,7 @@ func resourceAWSCloudRead(ctx context.Context, d *schema.ResourceData, meta inte
 		d.Set("guidance", cloud.GuidanceMode)
 		d.Set("costing", cloud.CostingMode)
 		d.Set("agent_install_mode", cloud.AgentMode)
+		setCloudNetworkProxies(d, resp.Body)
 		d.Set("account_number", cloud.ExternalID)
 		return diags
 	}
@@ -454,6 +475,7 @@ func resourceAWSCloudUpdate(ctx context.Context, d *schema.ResourceData, meta in
 	cloudType := make(map[string]interface{})
 	cloudType["code"] = "amazon"
 	cloud["zoneType"] = cloudType
+	cloudNetworkProxyPayload(d, cloud)
 
 	payload := map[string]interface{}{
 		"zone": cloud,
@@ -470,6 +492,13 @@ func resourceAWSCloudUpdate(ctx context.Context, d *schema.ResourceData, meta in
 	cloudOutput := result.Cloud
 	// Successfully updated resource, now set id
 	d.SetId(int64ToString(cloudOutput.ID))
+
+	if d.HasChange("refresh_triggers") {
+		err = refreshCloud(ctx, client, cloudOutput.ID, d.Get("wait_for_initial_sync").(bool), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.Errorf("error refreshing cloud: %s", err)
+		}
+	}
 	return resourceAWSCloudRead(ctx, d, meta)
 }
 
@@ -63,6 +63,16 @@ func resourceAzureCloud() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 			},
+			"api_proxy_id": {
+				Description: "The id of the network proxy used to communicate with the cloud API",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"provisioning_proxy_id": {
+				Description: "The id of the network proxy used by instances provisioned into the cloud",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
 			"enabled": {
 				Description: "Determines whether the cloud is active or not",
 				Type:        schema.TypeBool,
@@ -185,6 +195,8 @@ func resourceAzureCloud() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 			},
+			"wait_for_initial_sync": cloudWaitForInitialSyncSchema(),
+			"refresh_triggers":      cloudRefreshTriggersSchema(),
 		},
 	}
 }
@@ -263,6 +275,7 @@ func resourceAzureCloudCreate(ctx context.Context, d *schema.ResourceData, meta
 	cloudType := make(map[string]interface{})
 	cloudType["code"] = "azure"
 	cloud["zoneType"] = cloudType
+	cloudNetworkProxyPayload(d, cloud)
 
 	payload := map[string]interface{}{
 		"zone": cloud,
@@ -278,6 +291,8 @@ func resourceAzureCloudCreate(ctx context.Context, d *schema.ResourceData, meta
 	log.Printf("API RESPONSE: %s", resp)
 	result := resp.Result.(*morpheus.CreateCloudResult)
 	cloudOutput := result.Cloud
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(cloudOutput.ID))
 
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"initializing", "syncing"},
@@ -291,7 +306,7 @@ func resourceAzureCloudCreate(ctx context.Context, d *schema.ResourceData, meta
 			cloudStatus := result.Cloud
 			return result, cloudStatus.Status, nil
 		},
-		Timeout:      1 * time.Hour,
+		Timeout:      d.Timeout(schema.TimeoutCreate),
 		MinTimeout:   1 * time.Minute,
 		Delay:        1 * time.Minute,
 		PollInterval: 1 * time.Minute,
@@ -303,8 +318,13 @@ func resourceAzureCloudCreate(ctx context.Context, d *schema.ResourceData, meta
 		return diag.Errorf("error creating cloud: %s", err)
 	}
 
-	// Successfully created resource, now set id
-	d.SetId(int64ToString(cloudOutput.ID))
+	if d.Get("wait_for_initial_sync").(bool) {
+		err = waitForCloudSync(ctx, client, cloudOutput.ID, "", d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.Errorf("error waiting for initial cloud sync: %s", err)
+		}
+	}
+
 	resourceAzureCloudRead(ctx, d, meta)
 	return diags
 }
@@ -385,6 +405,7 @@ func resourceAzureCloudRead(ctx context.Context, d *schema.ResourceData, meta in
 		d.Set("guidance", cloud.GuidanceMode)
 		d.Set("costing", cloud.CostingMode)
 		d.Set("agent_install_mode", cloud.AgentMode)
+		setCloudNetworkProxies(d, resp.Body)
 		return diags
 	}
 }
@@ -460,6 +481,7 @@ func resourceAzureCloudUpdate(ctx context.Context, d *schema.ResourceData, meta
 	cloudType := make(map[string]interface{})
 	cloudType["code"] = "azure"
 	cloud["zoneType"] = cloudType
+	cloudNetworkProxyPayload(d, cloud)
 
 	payload := map[string]interface{}{
 		"zone": cloud,
@@ -476,6 +498,13 @@ func resourceAzureCloudUpdate(ctx context.Context, d *schema.ResourceData, meta
 	cloudOutput := result.Cloud
 	// Successfully updated resource, now set id
 	d.SetId(int64ToString(cloudOutput.ID))
+
+	if d.HasChange("refresh_triggers") {
+		err = refreshCloud(ctx, client, cloudOutput.ID, d.Get("wait_for_initial_sync").(bool), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.Errorf("error refreshing cloud: %s", err)
+		}
+	}
 	return resourceAzureCloudRead(ctx, d, meta)
 }
 
@@ -0,0 +1,318 @@
+package morpheus
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var (
+	// BackupServicesPath is the API endpoint for backup integrations
+	BackupServicesPath = "/api/backup-services"
+)
+
+func resourceBackupIntegration() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus backup integration resource, used to connect a backup provider such as Veeam, Commvault, Rubrik or Zerto",
+		CreateContext: resourceBackupIntegrationCreate,
+		ReadContext:   resourceBackupIntegrationRead,
+		UpdateContext: resourceBackupIntegrationUpdate,
+		DeleteContext: resourceBackupIntegrationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the backup integration",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the backup integration",
+				Required:    true,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Description: "The code of the backup integration type (i.e. - veeam, commvault, rubrik, zerto)",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether the backup integration is enabled",
+				Optional:    true,
+				Default:     true,
+			},
+			"visibility": {
+				Type:         schema.TypeString,
+				Description:  "Whether the backup integration is visible in sub-tenants or not (private, public)",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"private", "public"}, false),
+				Default:      "private",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Description: "The url of the backup provider api",
+				Required:    true,
+			},
+			"credential_id": {
+				Type:          schema.TypeInt,
+				Description:   "The ID of the credential store entry used for authentication",
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"username", "password"},
+			},
+			"username": {
+				Type:          schema.TypeString,
+				Description:   "The username used to authenticate with the backup provider",
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"credential_id"},
+			},
+			"password": {
+				Type:          schema.TypeString,
+				Description:   "The password used to authenticate with the backup provider",
+				Optional:      true,
+				Computed:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"credential_id"},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					h := sha256.New()
+					h.Write([]byte(new))
+					sha256_hash := hex.EncodeToString(h.Sum(nil))
+					return strings.EqualFold(old, sha256_hash)
+				},
+				DiffSuppressOnRefresh: true,
+			},
+			"config": {
+				Type:        schema.TypeMap,
+				Description: "The backup integration type specific configuration, keyed by the config property name",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"sensitive_config": {
+				Type:             schema.TypeMap,
+				Description:      "The backup integration type specific configuration that contains secrets, the values are stored as hashes in the state",
+				Optional:         true,
+				Sensitive:        true,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				DiffSuppressFunc: suppressHashedMapValueDiffs,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceBackupIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"backupService": backupIntegrationPayload(d),
+		},
+	}
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method:      "POST",
+		Path:        BackupServicesPath,
+		QueryParams: map[string]string{},
+		Body:        req.Body,
+		Result:      &BackupIntegrationResult{},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*BackupIntegrationResult)
+	backupService := result.BackupService
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(backupService.ID))
+	d.Set("sensitive_config", hashIntegrationSensitiveConfig(d))
+
+	resourceBackupIntegrationRead(ctx, d, meta)
+	return diags
+}
+
+func resourceBackupIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method:      "GET",
+		Path:        fmt.Sprintf("%s/%s", BackupServicesPath, id),
+		QueryParams: map[string]string{},
+		Result:      &BackupIntegrationResult{},
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	result := resp.Result.(*BackupIntegrationResult)
+	backupService := result.BackupService
+	d.SetId(int64ToString(backupService.ID))
+	d.Set("name", backupService.Name)
+	d.Set("type", backupService.Type.Code)
+	d.Set("enabled", backupService.Enabled)
+	d.Set("visibility", backupService.Visibility)
+	d.Set("url", backupService.ServiceUrl)
+	if backupService.Credential.ID != 0 {
+		d.Set("credential_id", backupService.Credential.ID)
+	} else {
+		d.Set("username", backupService.ServiceUsername)
+		d.Set("password", backupService.ServicePasswordHash)
+	}
+
+	// Only the configured keys are tracked since the backup
+	// integration type adds its own defaults to the config
+	config := make(map[string]interface{})
+	for k := range d.Get("config").(map[string]interface{}) {
+		if v, ok := backupService.Config[k]; ok && v != nil {
+			config[k] = fmt.Sprintf("%v", v)
+		}
+	}
+	d.Set("config", config)
+
+	return diags
+}
+
+func resourceBackupIntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+	id := d.Id()
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"backupService": backupIntegrationPayload(d),
+		},
+	}
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method:      "PUT",
+		Path:        fmt.Sprintf("%s/%s", BackupServicesPath, id),
+		QueryParams: map[string]string{},
+		Body:        req.Body,
+		Result:      &BackupIntegrationResult{},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.Set("sensitive_config", hashIntegrationSensitiveConfig(d))
+
+	return resourceBackupIntegrationRead(ctx, d, meta)
+}
+
+func resourceBackupIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	resp, err := client.Execute(&morpheus.Request{
+		Method:      "DELETE",
+		Path:        fmt.Sprintf("%s/%s", BackupServicesPath, id),
+		QueryParams: map[string]string{},
+		Result:      &morpheus.DeleteResult{},
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+func backupIntegrationPayload(d *schema.ResourceData) map[string]interface{} {
+	backupService := make(map[string]interface{})
+
+	backupService["name"] = d.Get("name").(string)
+	backupService["type"] = d.Get("type").(string)
+	backupService["enabled"] = d.Get("enabled").(bool)
+	backupService["visibility"] = d.Get("visibility").(string)
+	backupService["serviceUrl"] = d.Get("url").(string)
+
+	if v, ok := d.GetOk("credential_id"); ok {
+		backupService["credential"] = map[string]interface{}{
+			"type": "credential",
+			"id":   v.(int),
+		}
+	} else {
+		backupService["credential"] = map[string]interface{}{
+			"type": "local",
+		}
+		backupService["serviceUsername"] = d.Get("username").(string)
+		// The state holds the hash of the password so it is only sent when changed
+		if d.HasChange("password") {
+			backupService["servicePassword"] = d.Get("password").(string)
+		}
+	}
+
+	config := make(map[string]interface{})
+	for k, v := range d.Get("config").(map[string]interface{}) {
+		config[k] = v.(string)
+	}
+	for k, v := range changedIntegrationSensitiveConfig(d) {
+		config[k] = v
+	}
+	backupService["config"] = config
+
+	return backupService
+}
+
+type BackupIntegrationResult struct {
+	Success       bool              `json:"success"`
+	Message       string            `json:"msg"`
+	Errors        map[string]string `json:"errors"`
+	BackupService struct {
+		ID      int64  `json:"id"`
+		Name    string `json:"name"`
+		Enabled bool   `json:"enabled"`
+		Type    struct {
+			Code string `json:"code"`
+		} `json:"type"`
+		Visibility          string `json:"visibility"`
+		ServiceUrl          string `json:"serviceUrl"`
+		ServiceUsername     string `json:"serviceUsername"`
+		ServicePasswordHash string `json:"passwordHash"`
+		Credential          struct {
+			ID int64 `json:"id"`
+		} `json:"credential"`
+		Config map[string]interface{} `json:"config"`
+	} `json:"backupService"`
+}
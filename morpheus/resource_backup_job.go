@@ -0,0 +1,263 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var (
+	// BackupJobsPath is the API endpoint for backup jobs
+	BackupJobsPath = "/api/backups/jobs"
+)
+
+func resourceBackupJob() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus backup job resource",
+		CreateContext: resourceBackupJobCreate,
+		ReadContext:   resourceBackupJobRead,
+		UpdateContext: resourceBackupJobUpdate,
+		DeleteContext: resourceBackupJobDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the backup job",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the backup job",
+				Required:    true,
+			},
+			"code": {
+				Type:        schema.TypeString,
+				Description: "The code of the backup job",
+				Optional:    true,
+				Computed:    true,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether the backup job is enabled",
+				Optional:    true,
+				Default:     true,
+			},
+			"schedule_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the execution schedule used to run the backup job",
+				Optional:    true,
+			},
+			"retention_count": {
+				Type:         schema.TypeInt,
+				Description:  "The number of backups to retain",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"backup_integration_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the backup integration the backup job runs on, the internal Morpheus backup provider is used when not specified",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"storage_bucket_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the storage bucket the backups are written to",
+				Optional:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceBackupJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"job": backupJobPayload(d),
+		},
+	}
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method:      "POST",
+		Path:        BackupJobsPath,
+		QueryParams: map[string]string{},
+		Body:        req.Body,
+		Result:      &BackupJobResult{},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*BackupJobResult)
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(result.Job.ID))
+
+	resourceBackupJobRead(ctx, d, meta)
+	return diags
+}
+
+func resourceBackupJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method:      "GET",
+		Path:        fmt.Sprintf("%s/%s", BackupJobsPath, id),
+		QueryParams: map[string]string{},
+		Result:      &BackupJobResult{},
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	result := resp.Result.(*BackupJobResult)
+	job := result.Job
+	d.SetId(int64ToString(job.ID))
+	d.Set("name", job.Name)
+	d.Set("code", job.Code)
+	d.Set("enabled", job.Enabled)
+	d.Set("schedule_id", job.Schedule.ID)
+	d.Set("retention_count", job.RetentionCount)
+	d.Set("backup_integration_id", job.BackupProvider.ID)
+	d.Set("storage_bucket_id", job.StorageProvider.ID)
+
+	return diags
+}
+
+func resourceBackupJobUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+	id := d.Id()
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"job": backupJobPayload(d),
+		},
+	}
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method:      "PUT",
+		Path:        fmt.Sprintf("%s/%s", BackupJobsPath, id),
+		QueryParams: map[string]string{},
+		Body:        req.Body,
+		Result:      &BackupJobResult{},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	return resourceBackupJobRead(ctx, d, meta)
+}
+
+func resourceBackupJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	resp, err := client.Execute(&morpheus.Request{
+		Method:      "DELETE",
+		Path:        fmt.Sprintf("%s/%s", BackupJobsPath, id),
+		QueryParams: map[string]string{},
+		Result:      &morpheus.DeleteResult{},
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+func backupJobPayload(d *schema.ResourceData) map[string]interface{} {
+	job := make(map[string]interface{})
+
+	job["name"] = d.Get("name").(string)
+	job["enabled"] = d.Get("enabled").(bool)
+	if v, ok := d.GetOk("code"); ok {
+		job["code"] = v.(string)
+	}
+	if v, ok := d.GetOk("retention_count"); ok {
+		job["retentionCount"] = v.(int)
+	}
+	// The schedule and storage bucket are cleared when they are removed
+	if v, ok := d.GetOk("schedule_id"); ok {
+		job["scheduleId"] = v.(int)
+	} else {
+		job["scheduleId"] = nil
+	}
+	if v, ok := d.GetOk("backup_integration_id"); ok {
+		job["backupProvider"] = map[string]interface{}{
+			"id": v.(int),
+		}
+	}
+	if v, ok := d.GetOk("storage_bucket_id"); ok {
+		job["storageProvider"] = map[string]interface{}{
+			"id": v.(int),
+		}
+	} else {
+		job["storageProvider"] = nil
+	}
+
+	return job
+}
+
+type BackupJobResult struct {
+	Success bool              `json:"success"`
+	Message string            `json:"msg"`
+	Errors  map[string]string `json:"errors"`
+	Job     struct {
+		ID             int64  `json:"id"`
+		Name           string `json:"name"`
+		Code           string `json:"code"`
+		Enabled        bool   `json:"enabled"`
+		RetentionCount int64  `json:"retentionCount"`
+		Schedule       struct {
+			ID int64 `json:"id"`
+		} `json:"schedule"`
+		BackupProvider struct {
+			ID int64 `json:"id"`
+		} `json:"backupProvider"`
+		StorageProvider struct {
+			ID int64 `json:"id"`
+		} `json:"storageProvider"`
+	} `json:"job"`
+}
@@ -20,6 +20,10 @@ func resourceCloudFormationAppBlueprint() *schema.Resource {
 		ReadContext:   resourceCloudFormationAppBlueprintRead,
 		UpdateContext: resourceCloudFormationAppBlueprintUpdate,
 		DeleteContext: resourceCloudFormationAppBlueprintDelete,
+		CustomizeDiff: validateTemplateContent("blueprint_content", map[string]templateContentValidator{
+			"json": cloudFormationJSONContent,
+			"yaml": cloudFormationTemplateContent,
+		}),
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -75,7 +79,7 @@ func resourceCloudFormationAppBlueprint() *schema.Resource {
 			},
 			"blueprint_content": {
 				Type:        schema.TypeString,
-				Description: "The content of the cloud formation app blueprint. Used when the yaml or json source types are specified",
+				Description: "The content of the cloud formation app blueprint. Used when the yaml or json source types are specified, the content must be a CloudFormation template with the Resources property",
 				Optional:    true,
 				StateFunc: func(val interface{}) string {
 					return strings.TrimSuffix(val.(string), "\n")
@@ -21,6 +21,7 @@ func resourceCloudFormationSpecTemplate() *schema.Resource {
 		ReadContext:   resourceCloudFormationSpecTemplateRead,
 		UpdateContext: resourceCloudFormationSpecTemplateUpdate,
 		DeleteContext: resourceCloudFormationSpecTemplateDelete,
+		CustomizeDiff: validateTemplateContent("spec_content", map[string]templateContentValidator{"local": cloudFormationTemplateContent}),
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -41,7 +42,7 @@ func resourceCloudFormationSpecTemplate() *schema.Resource {
 			},
 			"spec_content": {
 				Type:        schema.TypeString,
-				Description: "The content of the cloud formation spec template. Used when the local source type is specified",
+				Description: "The content of the cloud formation spec template. Used when the local source type is specified, the content must be a CloudFormation template with the Resources property",
 				Optional:    true,
 				StateFunc: func(val interface{}) string {
 					return strings.TrimSuffix(val.(string), "\n")
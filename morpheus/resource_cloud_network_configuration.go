@@ -0,0 +1,285 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudNetworkConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus cloud network configuration resource, used to manage the access settings of a discovered network",
+		CreateContext: resourceCloudNetworkConfigurationCreate,
+		ReadContext:   resourceCloudNetworkConfigurationRead,
+		UpdateContext: resourceCloudNetworkConfigurationUpdate,
+		DeleteContext: resourceCloudNetworkConfigurationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The id of the cloud network",
+				Computed:    true,
+			},
+			"cloud_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the cloud the network was discovered in",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the discovered cloud network",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Description: "Whether the network is active and available for provisioning",
+				Optional:    true,
+				Computed:    true,
+			},
+			"dhcp_server": {
+				Type:        schema.TypeBool,
+				Description: "Whether the network has a DHCP server",
+				Optional:    true,
+				Computed:    true,
+			},
+			"allow_ip_override": {
+				Type:        schema.TypeBool,
+				Description: "Whether static IP addresses can be entered when provisioning into the network",
+				Optional:    true,
+				Computed:    true,
+			},
+			"visibility": {
+				Type:         schema.TypeString,
+				Description:  "Determines whether the network is visible in sub-tenants or not (private, public)",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"private", "public"}, false),
+			},
+			"group_access_all": {
+				Type:        schema.TypeBool,
+				Description: "Whether to grant all groups access to the network",
+				Optional:    true,
+				Computed:    true,
+			},
+			"group_access_ids": {
+				Type:        schema.TypeSet,
+				Description: "A list of group ids to grant access to the network",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"group_default_ids": {
+				Type:        schema.TypeSet,
+				Description: "A list of group ids that use the network as their default, the groups must also be granted access",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"plan_access_all": {
+				Type:        schema.TypeBool,
+				Description: "Whether to grant all service plans access to the network",
+				Optional:    true,
+				Computed:    true,
+			},
+			"plan_access_ids": {
+				Type:        schema.TypeSet,
+				Description: "A list of service plan ids to grant access to the network",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"tenant_ids": {
+				Type:        schema.TypeSet,
+				Description: "A list of tenant ids to grant access to the network",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceCloudNetworkConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	cloudId := d.Get("cloud_id").(int)
+	name := d.Get("name").(string)
+	// Find by name, then update by ID
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "GET",
+		Path:   morpheus.NetworksPath,
+		QueryParams: map[string]string{
+			"zoneId": strconv.Itoa(cloudId),
+			"name":   name,
+		},
+		Result: &CloudNetworkConfigurationListResult{},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	var networkIds []int64
+	for _, network := range resp.Result.(*CloudNetworkConfigurationListResult).Networks {
+		if network.Name == name && network.Zone.ID == int64(cloudId) {
+			networkIds = append(networkIds, network.ID)
+		}
+	}
+	if len(networkIds) != 1 {
+		return diag.Errorf("found %d networks named %s in cloud %d", len(networkIds), name, cloudId)
+	}
+	networkId := networkIds[0]
+
+	resp, err = client.Execute(&morpheus.Request{
+		Method:      "PUT",
+		Path:        fmt.Sprintf("%s/%d", morpheus.NetworksPath, networkId),
+		QueryParams: map[string]string{},
+		Body:        cloudNetworkConfigurationPayload(d),
+		Result:      &morpheus.StandardResult{},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(networkId))
+
+	resourceCloudNetworkConfigurationRead(ctx, d, meta)
+	return diags
+}
+
+func resourceCloudNetworkConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method:      "GET",
+		Path:        fmt.Sprintf("%s/%s", morpheus.NetworksPath, id),
+		QueryParams: map[string]string{},
+		Result:      &CloudNetworkConfigurationResult{},
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	result := resp.Result.(*CloudNetworkConfigurationResult)
+	network := result.Network
+	d.SetId(int64ToString(network.ID))
+	d.Set("cloud_id", network.Zone.ID)
+	d.Set("name", network.Name)
+	d.Set("active", network.Active)
+	d.Set("dhcp_server", network.DhcpServer)
+	d.Set("allow_ip_override", network.AllowStaticOverride)
+	d.Set("visibility", network.Visibility)
+	setCloudResourcePermissions(d, network.ResourcePermission, network.Tenants)
+
+	return diags
+}
+
+func resourceCloudNetworkConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	id := d.Id()
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method:      "PUT",
+		Path:        fmt.Sprintf("%s/%s", morpheus.NetworksPath, id),
+		QueryParams: map[string]string{},
+		Body:        cloudNetworkConfigurationPayload(d),
+		Result:      &morpheus.StandardResult{},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	return resourceCloudNetworkConfigurationRead(ctx, d, meta)
+}
+
+func resourceCloudNetworkConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	// The network is owned by the cloud so it is only removed from the state
+	d.SetId("")
+	return diags
+}
+
+func cloudNetworkConfigurationPayload(d *schema.ResourceData) map[string]interface{} {
+	network := map[string]interface{}{
+		"resourcePermissions": cloudResourcePermissionsPayload(d),
+		"tenants":             cloudResourceTenantsPayload(d),
+	}
+
+	if v, ok := d.GetOk("visibility"); ok {
+		network["visibility"] = v.(string)
+	}
+	// Only send the toggles that are configured so discovered values are kept
+	for key, attribute := range map[string]string{
+		"active":              "active",
+		"dhcpServer":          "dhcp_server",
+		"allowStaticOverride": "allow_ip_override",
+	} {
+		if v, ok := d.GetOkExists(attribute); ok {
+			network[key] = v.(bool)
+		}
+	}
+
+	return map[string]interface{}{
+		"network": network,
+	}
+}
+
+type CloudNetworkConfigurationResult struct {
+	Network struct {
+		ID   int64  `json:"id"`
+		Name string `json:"name"`
+		Zone struct {
+			ID int64 `json:"id"`
+		} `json:"zone"`
+		Active              bool                     `json:"active"`
+		DhcpServer          bool                     `json:"dhcpServer"`
+		AllowStaticOverride bool                     `json:"allowStaticOverride"`
+		Visibility          string                   `json:"visibility"`
+		Tenants             []CloudResourceTenant    `json:"tenants"`
+		ResourcePermission  CloudResourcePermissions `json:"resourcePermission"`
+	} `json:"network"`
+}
+
+type CloudNetworkConfigurationListResult struct {
+	Networks []struct {
+		ID   int64  `json:"id"`
+		Name string `json:"name"`
+		Zone struct {
+			ID int64 `json:"id"`
+		} `json:"zone"`
+	} `json:"networks"`
+}
@@ -0,0 +1,384 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudResourcePoolConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus cloud resource pool configuration resource, used to manage the access settings of a discovered resource pool",
+		CreateContext: resourceCloudResourcePoolConfigurationCreate,
+		ReadContext:   resourceCloudResourcePoolConfigurationRead,
+		UpdateContext: resourceCloudResourcePoolConfigurationUpdate,
+		DeleteContext: resourceCloudResourcePoolConfigurationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The id of the cloud resource pool",
+				Computed:    true,
+			},
+			"cloud_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the cloud the resource pool was discovered in",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the discovered cloud resource pool",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Description: "Whether the resource pool is active and available for provisioning",
+				Optional:    true,
+				Computed:    true,
+			},
+			"default_pool": {
+				Type:        schema.TypeBool,
+				Description: "Whether the resource pool is selected by default when provisioning into the cloud",
+				Optional:    true,
+				Computed:    true,
+			},
+			"inventory": {
+				Type:        schema.TypeBool,
+				Description: "Whether existing instances in the resource pool are inventoried",
+				Optional:    true,
+				Computed:    true,
+			},
+			"visibility": {
+				Type:         schema.TypeString,
+				Description:  "Determines whether the resource pool is visible in sub-tenants or not (private, public)",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"private", "public"}, false),
+			},
+			"group_access_all": {
+				Type:        schema.TypeBool,
+				Description: "Whether to grant all groups access to the resource pool",
+				Optional:    true,
+				Computed:    true,
+			},
+			"group_access_ids": {
+				Type:        schema.TypeSet,
+				Description: "A list of group ids to grant access to the resource pool",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"group_default_ids": {
+				Type:        schema.TypeSet,
+				Description: "A list of group ids that use the resource pool as their default, the groups must also be granted access",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"plan_access_all": {
+				Type:        schema.TypeBool,
+				Description: "Whether to grant all service plans access to the resource pool",
+				Optional:    true,
+				Computed:    true,
+			},
+			"plan_access_ids": {
+				Type:        schema.TypeSet,
+				Description: "A list of service plan ids to grant access to the resource pool",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"tenant_ids": {
+				Type:        schema.TypeSet,
+				Description: "A list of tenant ids to grant access to the resource pool",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudResourcePoolConfigurationImport,
+		},
+	}
+}
+
+func resourceCloudResourcePoolConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	cloudId := d.Get("cloud_id").(int)
+	name := d.Get("name").(string)
+	// Find by name, then update by ID
+	resp, err := client.Execute(&morpheus.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("%s/%d/resource-pools", morpheus.CloudsPath, cloudId),
+		QueryParams: map[string]string{
+			"name": name,
+		},
+		Result: &CloudResourcePoolConfigurationListResult{},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	var resourcePoolIds []int64
+	for _, resourcePool := range resp.Result.(*CloudResourcePoolConfigurationListResult).ResourcePools {
+		if resourcePool.Name == name {
+			resourcePoolIds = append(resourcePoolIds, resourcePool.ID)
+		}
+	}
+	if len(resourcePoolIds) != 1 {
+		return diag.Errorf("found %d resource pools named %s in cloud %d", len(resourcePoolIds), name, cloudId)
+	}
+	resourcePoolId := resourcePoolIds[0]
+
+	resp, err = client.Execute(&morpheus.Request{
+		Method:      "PUT",
+		Path:        fmt.Sprintf("%s/%d/resource-pools/%d", morpheus.CloudsPath, cloudId, resourcePoolId),
+		QueryParams: map[string]string{},
+		Body:        cloudResourcePoolConfigurationPayload(d),
+		Result:      &morpheus.StandardResult{},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(resourcePoolId))
+
+	resourceCloudResourcePoolConfigurationRead(ctx, d, meta)
+	return diags
+}
+
+func resourceCloudResourcePoolConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	cloudId := d.Get("cloud_id").(int)
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method:      "GET",
+		Path:        fmt.Sprintf("%s/%d/resource-pools/%s", morpheus.CloudsPath, cloudId, id),
+		QueryParams: map[string]string{},
+		Result:      &CloudResourcePoolConfigurationResult{},
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	result := resp.Result.(*CloudResourcePoolConfigurationResult)
+	resourcePool := result.ResourcePool
+	d.SetId(int64ToString(resourcePool.ID))
+	d.Set("cloud_id", resourcePool.Zone.ID)
+	d.Set("name", resourcePool.Name)
+	d.Set("active", resourcePool.Active)
+	d.Set("default_pool", resourcePool.DefaultPool)
+	d.Set("inventory", resourcePool.Inventory)
+	d.Set("visibility", resourcePool.Visibility)
+	setCloudResourcePermissions(d, resourcePool.ResourcePermission, resourcePool.Tenants)
+
+	return diags
+}
+
+func resourceCloudResourcePoolConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	id := d.Id()
+	cloudId := d.Get("cloud_id").(int)
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method:      "PUT",
+		Path:        fmt.Sprintf("%s/%d/resource-pools/%s", morpheus.CloudsPath, cloudId, id),
+		QueryParams: map[string]string{},
+		Body:        cloudResourcePoolConfigurationPayload(d),
+		Result:      &morpheus.StandardResult{},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	return resourceCloudResourcePoolConfigurationRead(ctx, d, meta)
+}
+
+func resourceCloudResourcePoolConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	// The resource pool is owned by the cloud so it is only removed from the state
+	d.SetId("")
+	return diags
+}
+
+// resourceCloudResourcePoolConfigurationImport accepts an import id in the format of
+// <cloud_id>:<resource_pool_id> since resource pools are nested under the cloud
+func resourceCloudResourcePoolConfigurationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected format of id (%s), expected cloud_id:resource_pool_id", d.Id())
+	}
+	d.Set("cloud_id", int(stringToInt64(parts[0])))
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, nil
+}
+
+func cloudResourcePoolConfigurationPayload(d *schema.ResourceData) map[string]interface{} {
+	resourcePool := map[string]interface{}{
+		"resourcePermissions": cloudResourcePermissionsPayload(d),
+		"tenants":             cloudResourceTenantsPayload(d),
+	}
+
+	if v, ok := d.GetOk("visibility"); ok {
+		resourcePool["visibility"] = v.(string)
+	}
+	// Only send the toggles that are configured so discovered values are kept
+	for key, attribute := range map[string]string{
+		"active":      "active",
+		"defaultPool": "default_pool",
+		"inventory":   "inventory",
+	} {
+		if v, ok := d.GetOkExists(attribute); ok {
+			resourcePool[key] = v.(bool)
+		}
+	}
+
+	return map[string]interface{}{
+		"resourcePool": resourcePool,
+	}
+}
+
+// cloudResourcePermissionsPayload builds the group and plan access payload
+// shared by the discovered cloud resource configuration resources
+func cloudResourcePermissionsPayload(d *schema.ResourceData) map[string]interface{} {
+	resourcePermissions := make(map[string]interface{})
+	if v, ok := d.GetOkExists("group_access_all"); ok {
+		resourcePermissions["all"] = v.(bool)
+	}
+	if v, ok := d.GetOkExists("plan_access_all"); ok {
+		resourcePermissions["allPlans"] = v.(bool)
+	}
+
+	defaultGroups := d.Get("group_default_ids").(*schema.Set)
+	sites := []map[string]interface{}{}
+	for _, v := range d.Get("group_access_ids").(*schema.Set).List() {
+		sites = append(sites, map[string]interface{}{
+			"id":      v,
+			"default": defaultGroups.Contains(v),
+		})
+	}
+	resourcePermissions["sites"] = sites
+
+	plans := []map[string]interface{}{}
+	for _, v := range d.Get("plan_access_ids").(*schema.Set).List() {
+		plans = append(plans, map[string]interface{}{
+			"id": v,
+		})
+	}
+	resourcePermissions["plans"] = plans
+
+	return resourcePermissions
+}
+
+func cloudResourceTenantsPayload(d *schema.ResourceData) []map[string]interface{} {
+	tenants := []map[string]interface{}{}
+	for _, v := range d.Get("tenant_ids").(*schema.Set).List() {
+		tenants = append(tenants, map[string]interface{}{
+			"id": v,
+		})
+	}
+	return tenants
+}
+
+// setCloudResourcePermissions stores the group, plan and tenant access of a
+// discovered cloud resource
+func setCloudResourcePermissions(d *schema.ResourceData, permissions CloudResourcePermissions, tenants []CloudResourceTenant) {
+	d.Set("group_access_all", permissions.All)
+	d.Set("plan_access_all", permissions.AllPlans)
+
+	var groupIds []int64
+	var defaultGroupIds []int64
+	for _, site := range permissions.Sites {
+		groupIds = append(groupIds, site.ID)
+		if site.Default {
+			defaultGroupIds = append(defaultGroupIds, site.ID)
+		}
+	}
+	d.Set("group_access_ids", groupIds)
+	d.Set("group_default_ids", defaultGroupIds)
+
+	var planIds []int64
+	for _, plan := range permissions.Plans {
+		planIds = append(planIds, plan.ID)
+	}
+	d.Set("plan_access_ids", planIds)
+
+	var tenantIds []int64
+	for _, tenant := range tenants {
+		tenantIds = append(tenantIds, tenant.ID)
+	}
+	d.Set("tenant_ids", tenantIds)
+}
+
+type CloudResourcePoolConfigurationResult struct {
+	ResourcePool struct {
+		ID   int64  `json:"id"`
+		Name string `json:"name"`
+		Zone struct {
+			ID int64 `json:"id"`
+		} `json:"zone"`
+		Active             bool                     `json:"active"`
+		DefaultPool        bool                     `json:"defaultPool"`
+		Inventory          bool                     `json:"inventory"`
+		Visibility         string                   `json:"visibility"`
+		Tenants            []CloudResourceTenant    `json:"tenants"`
+		ResourcePermission CloudResourcePermissions `json:"resourcePermission"`
+	} `json:"resourcePool"`
+}
+
+type CloudResourcePoolConfigurationListResult struct {
+	ResourcePools []struct {
+		ID   int64  `json:"id"`
+		Name string `json:"name"`
+	} `json:"resourcePools"`
+}
+
+type CloudResourcePermissions struct {
+	All      bool `json:"all"`
+	AllPlans bool `json:"allPlans"`
+	Sites    []struct {
+		ID      int64 `json:"id"`
+		Default bool  `json:"default"`
+	} `json:"sites"`
+	Plans []struct {
+		ID int64 `json:"id"`
+	} `json:"plans"`
+}
+
+type CloudResourceTenant struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
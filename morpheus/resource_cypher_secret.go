@@ -11,6 +11,7 @@ import (
 	"github.com/gomorpheus/morpheus-go-sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceCypherSecret() *schema.Resource {
@@ -32,6 +33,14 @@ func resourceCypherSecret() *schema.Resource {
 				Required:    true,
 				ForceNew:    true,
 			},
+			"mount": {
+				Type:         schema.TypeString,
+				Description:  "The cypher mount the secret is stored in, use vault to store the secret in the HashiCorp Vault cypher backend (secret, vault)",
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "secret",
+				ValidateFunc: validation.StringInSlice([]string{"secret", "vault"}, false),
+			},
 			"value": {
 				Type:        schema.TypeString,
 				Description: "The value of the cypher secret",
@@ -69,7 +78,7 @@ func resourceCypherSecretCreate(ctx context.Context, d *schema.ResourceData, met
 		},
 	}
 
-	secretPath := fmt.Sprintf("secret/%s", d.Get("key").(string))
+	secretPath := cypherSecretPath(d)
 	resp, err := client.CreateCypher(secretPath, req)
 	if err != nil {
 		log.Printf("API FAILURE: %s - %s", resp, err)
@@ -96,7 +105,7 @@ func resourceCypherSecretRead(ctx context.Context, d *schema.ResourceData, meta
 	var resp *morpheus.Response
 	var err error
 	if id != "" {
-		secretPath := fmt.Sprintf("secret/%s", d.Get("key").(string))
+		secretPath := cypherSecretPath(d)
 		resp, err = client.GetCypher(secretPath, &morpheus.Request{})
 	} else {
 		return diag.Errorf("Cypher cannot be read without id")
@@ -121,6 +130,7 @@ func resourceCypherSecretRead(ctx context.Context, d *schema.ResourceData, meta
 	if result.Cypher != nil {
 		d.SetId(int64ToString(result.Cypher.ID))
 		keyData := strings.Split(result.Cypher.ItemKey, "/")
+		d.Set("mount", keyData[0])
 		keyData = keyData[1:]
 		d.Set("key", strings.Join(keyData, "/"))
 		d.Set("ttl", result.LeaseDuration)
@@ -138,7 +148,7 @@ func resourceCypherSecretDelete(ctx context.Context, d *schema.ResourceData, met
 	var diags diag.Diagnostics
 
 	req := &morpheus.Request{}
-	secretPath := fmt.Sprintf("secret/%s", d.Get("key").(string))
+	secretPath := cypherSecretPath(d)
 	resp, err := client.DeleteCypher(secretPath, req)
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
@@ -153,3 +163,14 @@ func resourceCypherSecretDelete(ctx context.Context, d *schema.ResourceData, met
 	d.SetId("")
 	return diags
 }
+
+// cypherSecretPath returns the path of the secret including its mount, the
+// state of secrets created before the mount was supported has no mount so
+// the secret mount is used
+func cypherSecretPath(d *schema.ResourceData) string {
+	mount := d.Get("mount").(string)
+	if mount == "" {
+		mount = "secret"
+	}
+	return fmt.Sprintf("%s/%s", mount, d.Get("key").(string))
+}
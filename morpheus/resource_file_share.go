@@ -0,0 +1,285 @@
+package morpheus
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var (
+	// FileSharesPath is the API endpoint for file shares
+	FileSharesPath = "/api/file-shares"
+)
+
+func resourceFileShare() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus file share resource",
+		CreateContext: resourceFileShareCreate,
+		ReadContext:   resourceFileShareRead,
+		UpdateContext: resourceFileShareUpdate,
+		DeleteContext: resourceFileShareDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the file share",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the file share",
+				Required:    true,
+			},
+			"provider_type": {
+				Type:         schema.TypeString,
+				Description:  "The protocol of the file share (nfs, cifs)",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"nfs", "cifs"}, false),
+			},
+			"host": {
+				Type:        schema.TypeString,
+				Description: "The hostname or ip address of the file server",
+				Required:    true,
+			},
+			"export_folder": {
+				Type:        schema.TypeString,
+				Description: "The exported folder of an nfs file share or the share name of a cifs file share",
+				Required:    true,
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Description: "The username used to connect to the cifs file share",
+				Optional:    true,
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Description: "The password used to connect to the cifs file share",
+				Optional:    true,
+				Sensitive:   true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					h := sha256.New()
+					h.Write([]byte(new))
+					sha256_hash := hex.EncodeToString(h.Sum(nil))
+					return strings.EqualFold(old, sha256_hash)
+				},
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Description: "Whether the file share is active",
+				Optional:    true,
+				Default:     true,
+			},
+			"default_backup_target": {
+				Type:        schema.TypeBool,
+				Description: "Whether the file share is the default target for backups",
+				Optional:    true,
+				Default:     false,
+			},
+			"default_deployment_target": {
+				Type:        schema.TypeBool,
+				Description: "Whether the file share is the default target for deployment archives",
+				Optional:    true,
+				Default:     false,
+			},
+			"default_virtual_image_target": {
+				Type:        schema.TypeBool,
+				Description: "Whether the file share is the default target for virtual image uploads",
+				Optional:    true,
+				Default:     false,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceFileShareCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"fileShare": fileSharePayload(d),
+		},
+	}
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method:      "POST",
+		Path:        FileSharesPath,
+		QueryParams: map[string]string{},
+		Body:        req.Body,
+		Result:      &FileShareResult{},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	// Masking to avoid credential exposure
+	// log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*FileShareResult)
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(result.FileShare.ID))
+	setHashedSecrets(d, "password")
+
+	resourceFileShareRead(ctx, d, meta)
+	return diags
+}
+
+func resourceFileShareRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method:      "GET",
+		Path:        fmt.Sprintf("%s/%s", FileSharesPath, id),
+		QueryParams: map[string]string{},
+		Result:      &FileShareResult{},
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	// Masking to avoid credential exposure
+	// log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	result := resp.Result.(*FileShareResult)
+	fileShare := result.FileShare
+	d.SetId(int64ToString(fileShare.ID))
+	d.Set("name", fileShare.Name)
+	d.Set("provider_type", fileShare.ProviderType)
+	d.Set("host", fileShare.Config.Host)
+	d.Set("export_folder", fileShare.Config.ExportFolder)
+	d.Set("username", fileShare.Config.Username)
+	d.Set("active", fileShare.Active)
+	d.Set("default_backup_target", fileShare.DefaultBackupTarget)
+	d.Set("default_deployment_target", fileShare.DefaultDeploymentTarget)
+	d.Set("default_virtual_image_target", fileShare.DefaultVirtualImageTarget)
+
+	return diags
+}
+
+func resourceFileShareUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+	id := d.Id()
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"fileShare": fileSharePayload(d),
+		},
+	}
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method:      "PUT",
+		Path:        fmt.Sprintf("%s/%s", FileSharesPath, id),
+		QueryParams: map[string]string{},
+		Body:        req.Body,
+		Result:      &FileShareResult{},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	// Masking to avoid credential exposure
+	// log.Printf("API RESPONSE: %s", resp)
+	setHashedSecrets(d, "password")
+
+	return resourceFileShareRead(ctx, d, meta)
+}
+
+func resourceFileShareDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	resp, err := client.Execute(&morpheus.Request{
+		Method:      "DELETE",
+		Path:        fmt.Sprintf("%s/%s", FileSharesPath, id),
+		QueryParams: map[string]string{},
+		Result:      &morpheus.DeleteResult{},
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+func fileSharePayload(d *schema.ResourceData) map[string]interface{} {
+	fileShare := make(map[string]interface{})
+
+	fileShare["name"] = d.Get("name").(string)
+	fileShare["providerType"] = d.Get("provider_type").(string)
+	fileShare["active"] = d.Get("active").(bool)
+	fileShare["defaultBackupTarget"] = d.Get("default_backup_target").(bool)
+	fileShare["defaultDeploymentTarget"] = d.Get("default_deployment_target").(bool)
+	fileShare["defaultVirtualImageTarget"] = d.Get("default_virtual_image_target").(bool)
+
+	config := make(map[string]interface{})
+	config["host"] = d.Get("host").(string)
+	config["exportFolder"] = d.Get("export_folder").(string)
+	if d.Get("provider_type").(string) == "cifs" {
+		config["username"] = d.Get("username").(string)
+		// The state holds the hash of the password so it is only sent when changed
+		if d.HasChange("password") {
+			config["password"] = d.Get("password").(string)
+		}
+	}
+	fileShare["config"] = config
+
+	return fileShare
+}
+
+type FileShareResult struct {
+	Success   bool              `json:"success"`
+	Message   string            `json:"msg"`
+	Errors    map[string]string `json:"errors"`
+	FileShare struct {
+		ID           int64  `json:"id"`
+		Name         string `json:"name"`
+		ProviderType string `json:"providerType"`
+		Active       bool   `json:"active"`
+		Config       struct {
+			Host         string `json:"host"`
+			ExportFolder string `json:"exportFolder"`
+			Username     string `json:"username"`
+		} `json:"config"`
+		DefaultBackupTarget       bool `json:"defaultBackupTarget"`
+		DefaultDeploymentTarget   bool `json:"defaultDeploymentTarget"`
+		DefaultVirtualImageTarget bool `json:"defaultVirtualImageTarget"`
+	} `json:"fileShare"`
+}
@@ -0,0 +1,445 @@
+package morpheus
+
+import (
+	"context"
+	"sort"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceForm() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus form resource, the fields and field groups of the form are defined inline instead of as separate option types",
+		CreateContext: resourceFormCreate,
+		ReadContext:   resourceFormRead,
+		UpdateContext: resourceFormUpdate,
+		DeleteContext: resourceFormDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the form",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the form",
+				Required:    true,
+			},
+			"code": {
+				Type:        schema.TypeString,
+				Description: "The code of the form",
+				Required:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "The description of the form",
+				Optional:    true,
+			},
+			"labels": {
+				Type:        schema.TypeSet,
+				Description: "The organization labels associated with the form",
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"field": {
+				Type:        schema.TypeList,
+				Description: "The fields of the form that are not part of a field group, the fields are displayed in the order they are defined",
+				Optional:    true,
+				Elem:        &schema.Resource{Schema: formFieldSchema()},
+			},
+			"field_group": {
+				Type:        schema.TypeList,
+				Description: "The field groups of the form, the field groups are displayed after the fields of the form in the order they are defined",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The name of the field group",
+							Required:    true,
+						},
+						"code": {
+							Type:        schema.TypeString,
+							Description: "The code of the field group",
+							Optional:    true,
+							Computed:    true,
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Description: "The description of the field group",
+							Optional:    true,
+						},
+						"collapsible": {
+							Type:        schema.TypeBool,
+							Description: "Whether the field group can be collapsed",
+							Optional:    true,
+							Default:     false,
+						},
+						"default_collapsed": {
+							Type:        schema.TypeBool,
+							Description: "Whether the field group is collapsed by default",
+							Optional:    true,
+							Default:     false,
+						},
+						"visibility_field": {
+							Type:        schema.TypeString,
+							Description: "The field or code used to trigger the visibility of the field group",
+							Optional:    true,
+						},
+						"field": {
+							Type:        schema.TypeList,
+							Description: "The fields of the field group, the fields are displayed in the order they are defined",
+							Optional:    true,
+							Elem:        &schema.Resource{Schema: formFieldSchema()},
+						},
+					},
+				},
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// formFieldSchema returns the schema of a field of the form, fields are
+// defined the same way at the form level and within a field group
+func formFieldSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Description: "The name of the field",
+			Optional:    true,
+			Computed:    true,
+		},
+		"code": {
+			Type:        schema.TypeString,
+			Description: "The code of the field, used by other fields to depend on the field",
+			Optional:    true,
+			Computed:    true,
+		},
+		"type": {
+			Type:        schema.TypeString,
+			Description: "The type of the field (i.e. - text, textarea, number, select, multiSelect, checkbox, radio, hidden, password, typeahead, multiTypeahead)",
+			Required:    true,
+		},
+		"field_name": {
+			Type:        schema.TypeString,
+			Description: "The field name of the field, the value of the field is passed in the request under this name",
+			Required:    true,
+		},
+		"field_label": {
+			Type:        schema.TypeString,
+			Description: "The label associated with the field in the UI",
+			Optional:    true,
+		},
+		"field_context": {
+			Type:        schema.TypeString,
+			Description: "The context of the field, the value of the field is passed in the request under this context",
+			Optional:    true,
+			Default:     "config",
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Description: "The description of the field",
+			Optional:    true,
+		},
+		"option_list_id": {
+			Type:        schema.TypeInt,
+			Description: "The ID of the option list that provides the values of a select, radio or typeahead field",
+			Optional:    true,
+		},
+		"default_value": {
+			Type:        schema.TypeString,
+			Description: "The default value of the field",
+			Optional:    true,
+		},
+		"placeholder": {
+			Type:        schema.TypeString,
+			Description: "The placeholder text displayed in the field",
+			Optional:    true,
+		},
+		"help_block": {
+			Type:        schema.TypeString,
+			Description: "Text that provides additional details about the use of the field",
+			Optional:    true,
+		},
+		"required": {
+			Type:        schema.TypeBool,
+			Description: "Whether the field is required",
+			Optional:    true,
+			Default:     false,
+		},
+		"verify_pattern": {
+			Type:        schema.TypeString,
+			Description: "The regex pattern used to validate the value of the field",
+			Optional:    true,
+		},
+		"min_value": {
+			Type:        schema.TypeInt,
+			Description: "The minimum value of a number field",
+			Optional:    true,
+		},
+		"max_value": {
+			Type:        schema.TypeInt,
+			Description: "The maximum value of a number field",
+			Optional:    true,
+		},
+		"dependent_field": {
+			Type:        schema.TypeString,
+			Description: "The field or code used to trigger the reloading of the field",
+			Optional:    true,
+		},
+		"visibility_field": {
+			Type:        schema.TypeString,
+			Description: "The field or code used to trigger the visibility of the field",
+			Optional:    true,
+		},
+		"require_field": {
+			Type:        schema.TypeString,
+			Description: "The field or code used to determine whether the field is required or not",
+			Optional:    true,
+		},
+	}
+}
+
+func resourceFormCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"optionTypeForm": formPayload(d),
+		},
+	}
+	resp, err := client.CreateForm(req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.CreateFormResult)
+	form := result.Form
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(form.ID))
+
+	resourceFormRead(ctx, d, meta)
+	return diags
+}
+
+func resourceFormRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+
+	resp, err := client.GetForm(toInt64(id), &morpheus.Request{})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	result := resp.Result.(*morpheus.GetFormResult)
+	form := result.Form
+	if form == nil {
+		d.SetId("")
+		return diags
+	}
+	d.SetId(int64ToString(form.ID))
+	d.Set("name", form.Name)
+	d.Set("code", form.Code)
+	d.Set("description", form.Description)
+	d.Set("labels", form.Labels)
+	d.Set("field", flattenFormFields(form.Options))
+
+	var fieldGroups []map[string]interface{}
+	for _, fieldGroup := range form.FieldGroups {
+		fieldGroups = append(fieldGroups, map[string]interface{}{
+			"name":              fieldGroup.Name,
+			"code":              fieldGroup.Code,
+			"description":       fieldGroup.Description,
+			"collapsible":       fieldGroup.Collapsible,
+			"default_collapsed": fieldGroup.DefaultCollapsed,
+			"visibility_field":  fieldGroup.VisibleOnCode,
+			"field":             flattenFormFields(fieldGroup.Options),
+		})
+	}
+	d.Set("field_group", fieldGroups)
+
+	return diags
+}
+
+func resourceFormUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+	id := d.Id()
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"optionTypeForm": formPayload(d),
+		},
+	}
+	resp, err := client.UpdateForm(toInt64(id), req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	return resourceFormRead(ctx, d, meta)
+}
+
+func resourceFormDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	req := &morpheus.Request{}
+	resp, err := client.DeleteForm(toInt64(id), req)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+func formPayload(d *schema.ResourceData) map[string]interface{} {
+	form := make(map[string]interface{})
+
+	form["name"] = d.Get("name").(string)
+	form["code"] = d.Get("code").(string)
+	form["description"] = d.Get("description").(string)
+
+	labelsPayload := make([]string, 0)
+	if attr, ok := d.GetOk("labels"); ok {
+		for _, s := range attr.(*schema.Set).List() {
+			labelsPayload = append(labelsPayload, s.(string))
+		}
+	}
+	form["labels"] = labelsPayload
+	form["options"] = formFieldsPayload(d.Get("field").([]interface{}))
+
+	fieldGroups := make([]map[string]interface{}, 0)
+	for _, v := range d.Get("field_group").([]interface{}) {
+		fieldGroup := v.(map[string]interface{})
+		fieldGroupPayload := map[string]interface{}{
+			"name":             fieldGroup["name"].(string),
+			"description":      fieldGroup["description"].(string),
+			"collapsible":      fieldGroup["collapsible"].(bool),
+			"defaultCollapsed": fieldGroup["default_collapsed"].(bool),
+			"visibleOnCode":    fieldGroup["visibility_field"].(string),
+			"options":          formFieldsPayload(fieldGroup["field"].([]interface{})),
+		}
+		if fieldGroup["code"].(string) != "" {
+			fieldGroupPayload["code"] = fieldGroup["code"].(string)
+		}
+		fieldGroups = append(fieldGroups, fieldGroupPayload)
+	}
+	form["fieldGroups"] = fieldGroups
+
+	return form
+}
+
+// formFieldsPayload builds the options of the form or field group, the
+// display order follows the order of the field blocks
+func formFieldsPayload(fields []interface{}) []map[string]interface{} {
+	options := make([]map[string]interface{}, 0)
+	for index, v := range fields {
+		field := v.(map[string]interface{})
+		option := map[string]interface{}{
+			"type":          field["type"].(string),
+			"fieldName":     field["field_name"].(string),
+			"fieldLabel":    field["field_label"].(string),
+			"fieldContext":  field["field_context"].(string),
+			"description":   field["description"].(string),
+			"defaultValue":  field["default_value"].(string),
+			"placeHolder":   field["placeholder"].(string),
+			"helpBlock":     field["help_block"].(string),
+			"required":      field["required"].(bool),
+			"verifyPattern": field["verify_pattern"].(string),
+			"dependsOnCode": field["dependent_field"].(string),
+			"visibleOnCode": field["visibility_field"].(string),
+			"requireOnCode": field["require_field"].(string),
+			"displayOrder":  index,
+		}
+		if name := field["name"].(string); name != "" {
+			option["name"] = name
+		} else {
+			option["name"] = field["field_name"].(string)
+		}
+		if code := field["code"].(string); code != "" {
+			option["code"] = code
+		}
+		if optionListID := field["option_list_id"].(int); optionListID != 0 {
+			option["optionList"] = map[string]interface{}{
+				"id": optionListID,
+			}
+		}
+		if minValue := field["min_value"].(int); minValue != 0 {
+			option["minVal"] = minValue
+		}
+		if maxValue := field["max_value"].(int); maxValue != 0 {
+			option["maxVal"] = maxValue
+		}
+		options = append(options, option)
+	}
+	return options
+}
+
+func flattenFormFields(options []morpheus.Option) []map[string]interface{} {
+	sort.SliceStable(options, func(i, j int) bool {
+		return options[i].DisplayOrder < options[j].DisplayOrder
+	})
+	var fields []map[string]interface{}
+	for _, option := range options {
+		fields = append(fields, map[string]interface{}{
+			"name":             option.Name,
+			"code":             option.Code,
+			"type":             option.Type,
+			"field_name":       option.FieldName,
+			"field_label":      option.FieldLabel,
+			"field_context":    option.FieldContext,
+			"description":      option.Description,
+			"option_list_id":   option.OptionList.ID,
+			"default_value":    option.DefaultValue,
+			"placeholder":      option.PlaceHolder,
+			"help_block":       option.HelpBlock,
+			"required":         option.Required,
+			"verify_pattern":   option.VerifyPattern,
+			"min_value":        option.MinVal,
+			"max_value":        option.MaxVal,
+			"dependent_field":  option.DependsOnCode,
+			"visibility_field": option.VisibleOnCode,
+			"require_field":    option.RequireOnCode,
+		})
+	}
+	return fields
+}
@@ -21,6 +21,7 @@ func resourceHelmSpecTemplate() *schema.Resource {
 		ReadContext:   resourceHelmSpecTemplateRead,
 		UpdateContext: resourceHelmSpecTemplateUpdate,
 		DeleteContext: resourceHelmSpecTemplateDelete,
+		CustomizeDiff: validateTemplateContent("spec_content", map[string]templateContentValidator{"local": helmTemplateContent}),
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -41,7 +42,7 @@ func resourceHelmSpecTemplate() *schema.Resource {
 			},
 			"spec_content": {
 				Type:        schema.TypeString,
-				Description: "The content of the helm spec template. Used when the local source type is specified",
+				Description: "The content of the helm spec template. Used when the local source type is specified, the template syntax of the content is validated during the plan",
 				Optional:    true,
 				StateFunc: func(val interface{}) string {
 					return strings.TrimSuffix(val.(string), "\n")
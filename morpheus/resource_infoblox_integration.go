@@ -0,0 +1,350 @@
+package morpheus
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceInfobloxIntegration() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides an Infoblox IPAM and DNS integration resource",
+		CreateContext: resourceInfobloxIntegrationCreate,
+		ReadContext:   resourceInfobloxIntegrationRead,
+		UpdateContext: resourceInfobloxIntegrationUpdate,
+		DeleteContext: resourceInfobloxIntegrationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the Infoblox integration",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the Infoblox integration",
+				Required:    true,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether the Infoblox integration is enabled",
+				Optional:    true,
+				Default:     true,
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Description: "The url of the Infoblox WAPI endpoint (i.e. - https://infoblox.example.com/wapi/v2.2.1)",
+				Required:    true,
+			},
+			"credential_id": {
+				Type:          schema.TypeInt,
+				Description:   "The ID of the credential store entry used for authentication",
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"username", "password"},
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Description: "The username of the account used to authenticate to Infoblox",
+				Optional:    true,
+				Computed:    true,
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Description: "The password of the account used to authenticate to Infoblox",
+				Optional:    true,
+				Computed:    true,
+				Sensitive:   true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					h := sha256.New()
+					h.Write([]byte(new))
+					sha256_hash := hex.EncodeToString(h.Sum(nil))
+					return strings.EqualFold(old, sha256_hash)
+				},
+				DiffSuppressOnRefresh: true,
+			},
+			"throttle_rate": {
+				Type:         schema.TypeInt,
+				Description:  "The number of milliseconds to wait between API calls to Infoblox",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"ignore_ssl_errors": {
+				Type:        schema.TypeBool,
+				Description: "Whether to ignore SSL certificate errors when connecting to Infoblox",
+				Optional:    true,
+				Computed:    true,
+			},
+			"inventory_existing": {
+				Type:        schema.TypeBool,
+				Description: "Whether to inventory the existing IP address records",
+				Optional:    true,
+				Computed:    true,
+			},
+			"network_filter": {
+				Type:        schema.TypeString,
+				Description: "A filter used to limit the networks that are synced from Infoblox",
+				Optional:    true,
+				Computed:    true,
+			},
+			"zone_filter": {
+				Type:        schema.TypeString,
+				Description: "A filter used to limit the DNS zones that are synced from Infoblox",
+				Optional:    true,
+				Computed:    true,
+			},
+			"tenant_match": {
+				Type:        schema.TypeString,
+				Description: "The Infoblox extensible attribute used to match network pools to a Morpheus tenant",
+				Optional:    true,
+				Computed:    true,
+			},
+			"extra_attributes": {
+				Type:             schema.TypeString,
+				Description:      "A JSON map of extensible attributes that are set on records created by Morpheus",
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceInfobloxIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"integration": infobloxIntegrationPayload(d),
+		},
+	}
+
+	resp, err := client.CreateIntegration(req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.CreateIntegrationResult)
+	integrationResult := result.Integration
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(integrationResult.ID))
+
+	resourceInfobloxIntegrationRead(ctx, d, meta)
+	return diags
+}
+
+func resourceInfobloxIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	integration, resp, err := getNetworkIntegration(client, d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	d.SetId(int64ToString(integration.ID))
+	d.Set("name", integration.Name)
+	d.Set("enabled", integration.Enabled)
+	d.Set("url", integration.serviceURL())
+	d.Set("credential_id", integration.Credential.ID)
+	d.Set("username", integration.ServiceUsername)
+	d.Set("password", integration.PasswordHash)
+	d.Set("throttle_rate", integration.ServiceThrottleRate)
+	d.Set("ignore_ssl_errors", integration.IgnoreSsl)
+	d.Set("inventory_existing", networkIntegrationConfigBool(integration.Config["inventoryExisting"]))
+	d.Set("network_filter", integration.NetworkFilter)
+	d.Set("zone_filter", integration.ZoneFilter)
+	d.Set("tenant_match", integration.TenantMatch)
+	if extraAttributes, ok := integration.Config["extraAttributes"].(string); ok {
+		d.Set("extra_attributes", extraAttributes)
+	}
+	return diags
+}
+
+func resourceInfobloxIntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+	id := d.Id()
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"integration": infobloxIntegrationPayload(d),
+		},
+	}
+
+	resp, err := client.UpdateIntegration(toInt64(id), req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	result := resp.Result.(*morpheus.UpdateIntegrationResult)
+	integrationResult := result.Integration
+
+	// Successfully updated resource, now set id
+	// err, it should not have changed though..
+	d.SetId(int64ToString(integrationResult.ID))
+	return resourceInfobloxIntegrationRead(ctx, d, meta)
+}
+
+func resourceInfobloxIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	req := &morpheus.Request{}
+	resp, err := client.DeleteIntegration(toInt64(id), req)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return diag.FromErr(err)
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+func infobloxIntegrationPayload(d *schema.ResourceData) map[string]interface{} {
+	integration := make(map[string]interface{})
+	integration["name"] = d.Get("name").(string)
+	integration["enabled"] = d.Get("enabled").(bool)
+	integration["type"] = "infoblox"
+	integration["serviceUrl"] = d.Get("url").(string)
+	integration["serviceThrottleRate"] = d.Get("throttle_rate").(int)
+	integration["ignoreSsl"] = d.Get("ignore_ssl_errors").(bool)
+	integration["networkFilter"] = d.Get("network_filter").(string)
+	integration["zoneFilter"] = d.Get("zone_filter").(string)
+	integration["tenantMatch"] = d.Get("tenant_match").(string)
+
+	if d.Get("credential_id").(int) != 0 {
+		credential := make(map[string]interface{})
+		credential["type"] = "username-password"
+		credential["id"] = d.Get("credential_id").(int)
+		integration["credential"] = credential
+	} else {
+		credential := make(map[string]interface{})
+		credential["type"] = "local"
+		integration["credential"] = credential
+		integration["serviceUsername"] = d.Get("username").(string)
+		// The state holds the hash of the password so it is only sent when changed
+		if d.HasChange("password") {
+			integration["servicePassword"] = d.Get("password").(string)
+		}
+	}
+
+	config := make(map[string]interface{})
+	if d.Get("inventory_existing").(bool) {
+		config["inventoryExisting"] = "on"
+	} else {
+		config["inventoryExisting"] = "off"
+	}
+	config["extraAttributes"] = d.Get("extra_attributes").(string)
+	integration["config"] = config
+
+	return integration
+}
+
+// getNetworkIntegration fetches an integration using a local result structure
+// since the sdk integration type does not include the network service fields
+func getNetworkIntegration(client *morpheus.Client, id string) (*NetworkIntegration, *morpheus.Response, error) {
+	if id == "" {
+		return nil, nil, fmt.Errorf("integration cannot be read without an id")
+	}
+	resp, err := client.Execute(&morpheus.Request{
+		Method:      "GET",
+		Path:        fmt.Sprintf("%s/%d", morpheus.IntegrationsPath, toInt64(id)),
+		QueryParams: map[string]string{},
+		Result:      &NetworkIntegrationResult{},
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+	result := resp.Result.(*NetworkIntegrationResult)
+	if result.Integration == nil {
+		return nil, resp, fmt.Errorf("integration not found in response data")
+	}
+	return result.Integration, resp, nil
+}
+
+// networkIntegrationConfigBool handles config values that the API returns
+// as either a boolean or an on/off string
+func networkIntegrationConfigBool(v interface{}) bool {
+	switch value := v.(type) {
+	case bool:
+		return value
+	case string:
+		return value == "on" || value == "true"
+	}
+	return false
+}
+
+type NetworkIntegrationResult struct {
+	Integration *NetworkIntegration `json:"integration"`
+}
+
+type NetworkIntegration struct {
+	ID                  int64                  `json:"id"`
+	Name                string                 `json:"name"`
+	Enabled             bool                   `json:"enabled"`
+	Type                string                 `json:"type"`
+	URL                 string                 `json:"url"`
+	ServiceUrl          string                 `json:"serviceUrl"`
+	ServiceUsername     string                 `json:"serviceUsername"`
+	PasswordHash        string                 `json:"passwordHash"`
+	TokenHash           string                 `json:"tokenHash"`
+	ServiceVersion      string                 `json:"serviceVersion"`
+	ServiceThrottleRate int64                  `json:"serviceThrottleRate"`
+	IgnoreSsl           bool                   `json:"ignoreSsl"`
+	NetworkFilter       string                 `json:"networkFilter"`
+	ZoneFilter          string                 `json:"zoneFilter"`
+	TenantMatch         string                 `json:"tenantMatch"`
+	Config              map[string]interface{} `json:"config"`
+	Credential          struct {
+		ID   int64  `json:"id"`
+		Name string `json:"name"`
+		Type string `json:"type"`
+	} `json:"credential"`
+}
+
+func (i *NetworkIntegration) serviceURL() string {
+	if i.ServiceUrl != "" {
+		return i.ServiceUrl
+	}
+	return i.URL
+}
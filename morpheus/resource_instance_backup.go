@@ -0,0 +1,241 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	// BackupsPath is the API endpoint for backups
+	BackupsPath = "/api/backups"
+)
+
+func resourceInstanceBackup() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus instance backup resource, used to enroll an instance in a backup job",
+		CreateContext: resourceInstanceBackupCreate,
+		ReadContext:   resourceInstanceBackupRead,
+		UpdateContext: resourceInstanceBackupUpdate,
+		DeleteContext: resourceInstanceBackupDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the instance backup",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the instance backup",
+				Required:    true,
+			},
+			"instance_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the instance to back up",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"container_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the instance container to back up, the first container of the instance is used when not specified",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"backup_job_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the backup job the instance is enrolled in",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"backup_type": {
+				Type:        schema.TypeString,
+				Description: "The code of the backup type, the default backup type of the instance is used when not specified",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether the instance backup is enabled",
+				Optional:    true,
+				Default:     true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceInstanceBackupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	backup := make(map[string]interface{})
+	backup["name"] = d.Get("name").(string)
+	backup["enabled"] = d.Get("enabled").(bool)
+	backup["locationType"] = "instance"
+	backup["instanceId"] = d.Get("instance_id").(int)
+	if v, ok := d.GetOk("container_id"); ok {
+		backup["containerId"] = v.(int)
+	}
+	if v, ok := d.GetOk("backup_type"); ok {
+		backup["backupType"] = v.(string)
+	}
+	// Add the backup to the existing job instead of creating a new job
+	backup["jobAction"] = "addTo"
+	backup["jobId"] = d.Get("backup_job_id").(int)
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"backup": backup,
+		},
+	}
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method:      "POST",
+		Path:        BackupsPath,
+		QueryParams: map[string]string{},
+		Body:        req.Body,
+		Result:      &InstanceBackupResult{},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*InstanceBackupResult)
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(result.Backup.ID))
+
+	resourceInstanceBackupRead(ctx, d, meta)
+	return diags
+}
+
+func resourceInstanceBackupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method:      "GET",
+		Path:        fmt.Sprintf("%s/%s", BackupsPath, id),
+		QueryParams: map[string]string{},
+		Result:      &InstanceBackupResult{},
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	result := resp.Result.(*InstanceBackupResult)
+	backup := result.Backup
+	d.SetId(int64ToString(backup.ID))
+	d.Set("name", backup.Name)
+	d.Set("instance_id", backup.Instance.ID)
+	d.Set("container_id", backup.ContainerID)
+	d.Set("backup_job_id", backup.Job.ID)
+	d.Set("backup_type", backup.BackupType.Code)
+	d.Set("enabled", backup.Enabled)
+
+	return diags
+}
+
+func resourceInstanceBackupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+	id := d.Id()
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"backup": map[string]interface{}{
+				"name":    d.Get("name").(string),
+				"enabled": d.Get("enabled").(bool),
+			},
+		},
+	}
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method:      "PUT",
+		Path:        fmt.Sprintf("%s/%s", BackupsPath, id),
+		QueryParams: map[string]string{},
+		Body:        req.Body,
+		Result:      &InstanceBackupResult{},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	return resourceInstanceBackupRead(ctx, d, meta)
+}
+
+func resourceInstanceBackupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	resp, err := client.Execute(&morpheus.Request{
+		Method:      "DELETE",
+		Path:        fmt.Sprintf("%s/%s", BackupsPath, id),
+		QueryParams: map[string]string{},
+		Result:      &morpheus.DeleteResult{},
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+type InstanceBackupResult struct {
+	Success bool              `json:"success"`
+	Message string            `json:"msg"`
+	Errors  map[string]string `json:"errors"`
+	Backup  struct {
+		ID          int64  `json:"id"`
+		Name        string `json:"name"`
+		Enabled     bool   `json:"enabled"`
+		ContainerID int64  `json:"containerId"`
+		Instance    struct {
+			ID int64 `json:"id"`
+		} `json:"instance"`
+		Job struct {
+			ID int64 `json:"id"`
+		} `json:"job"`
+		BackupType struct {
+			Code string `json:"code"`
+		} `json:"backupType"`
+	} `json:"backup"`
+}
@@ -3,7 +3,6 @@ package morpheus
 import (
 	"context"
 	"encoding/json"
-	"os"
 	"strings"
 
 	"log"
@@ -21,6 +20,7 @@ func resourceInstanceCatalogItem() *schema.Resource {
 		ReadContext:   resourceInstanceCatalogItemRead,
 		UpdateContext: resourceInstanceCatalogItemUpdate,
 		DeleteContext: resourceInstanceCatalogItemDelete,
+		CustomizeDiff: setLogoImageChecksums(logoImageAttributes),
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -79,6 +79,12 @@ func resourceInstanceCatalogItem() *schema.Resource {
 				Required:         true,
 				DiffSuppressFunc: suppressEquivalentJsonDiffs,
 			},
+			"form_id": {
+				Type:          schema.TypeInt,
+				Description:   "The ID of the form associated with the instance catalog item, used instead of the option types",
+				Optional:      true,
+				ConflictsWith: []string{"option_type_ids"},
+			},
 			"option_type_ids": {
 				Type:        schema.TypeList,
 				Description: "The list of option type ids associated with the instance catalog item",
@@ -101,6 +107,18 @@ func resourceInstanceCatalogItem() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 			},
+			"logo_content_base64": {
+				Type:          schema.TypeString,
+				Description:   "The base64 encoded content of the instance catalog item logo image, used instead of the image_path so the image does not need to exist on the machine running terraform",
+				Optional:      true,
+				ConflictsWith: []string{"image_path"},
+				RequiredWith:  []string{"image_name"},
+			},
+			"logo_checksum": {
+				Type:        schema.TypeString,
+				Description: "The SHA256 checksum of the instance catalog item logo image, the logo is uploaded again when the content of the image changes",
+				Computed:    true,
+			},
 			"visibility": {
 				Type:         schema.TypeString,
 				Description:  "The visibility of the instance catalog item (public or private)",
@@ -129,6 +147,14 @@ func resourceInstanceCatalogItemCreate(ctx context.Context, d *schema.ResourceDa
 	catalogItem["featured"] = d.Get("featured").(bool)
 	catalogItem["type"] = "instance"
 	catalogItem["optionTypes"] = d.Get("option_type_ids")
+	if formID, ok := d.GetOk("form_id"); ok {
+		catalogItem["formType"] = "form"
+		catalogItem["form"] = map[string]interface{}{
+			"id": formID.(int),
+		}
+	} else {
+		catalogItem["formType"] = "optionTypes"
+	}
 	catalogItem["content"] = d.Get("content").(string)
 	catalogItem["visibility"] = d.Get("visibility").(string)
 
@@ -162,19 +188,11 @@ func resourceInstanceCatalogItemCreate(ctx context.Context, d *schema.ResourceDa
 	result := resp.Result.(*morpheus.CreateCatalogItemResult)
 	catalogItemResult := result.CatalogItem
 
-	if d.Get("image_path") != "" && d.Get("image_name") != "" {
-		data, err := os.ReadFile(d.Get("image_path").(string))
-		if err != nil {
-			return diag.FromErr(err)
-		}
-
-		var filePayloads []*morpheus.FilePayload
-		filePayload := &morpheus.FilePayload{
-			ParameterName: "logo",
-			FileName:      d.Get("image_name").(string),
-			FileContent:   data,
-		}
-		filePayloads = append(filePayloads, filePayload)
+	filePayloads, err := logoImagePayloads(d, logoImageAttributes)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(filePayloads) > 0 {
 		response, err := client.UpdateCatalogItemLogo(catalogItemResult.ID, filePayloads, &morpheus.Request{})
 		if err != nil {
 			log.Printf("API FAILURE: %s - %s", response, err)
@@ -242,6 +260,11 @@ func resourceInstanceCatalogItemRead(ctx context.Context, d *schema.ResourceData
 		}
 	}
 	d.Set("option_type_ids", optionTypes)
+	if catalogItem.FormType == "form" {
+		d.Set("form_id", catalogItem.Form.ID)
+	} else {
+		d.Set("form_id", 0)
+	}
 	d.Set("content", catalogItem.Content)
 	configJson, _ := json.Marshal(catalogItem.Config.(map[string]interface{}))
 	d.Set("config", string(configJson))
@@ -266,6 +289,14 @@ func resourceInstanceCatalogItemUpdate(ctx context.Context, d *schema.ResourceDa
 	catalogItem["featured"] = d.Get("featured").(bool)
 	catalogItem["type"] = "instance"
 	catalogItem["optionTypes"] = d.Get("option_type_ids")
+	if formID, ok := d.GetOk("form_id"); ok {
+		catalogItem["formType"] = "form"
+		catalogItem["form"] = map[string]interface{}{
+			"id": formID.(int),
+		}
+	} else {
+		catalogItem["formType"] = "optionTypes"
+	}
 	catalogItem["content"] = d.Get("content").(string)
 	catalogItem["visibility"] = d.Get("visibility").(string)
 
@@ -299,20 +330,17 @@ func resourceInstanceCatalogItemUpdate(ctx context.Context, d *schema.ResourceDa
 	result := resp.Result.(*morpheus.UpdateCatalogItemResult)
 	catalogItemResult := result.CatalogItem
 
-	if d.HasChange("image_name") || d.HasChange("image_path") {
-		data, err := os.ReadFile(d.Get("image_path").(string))
+	filePayloads, err := logoImagePayloads(d, logoImageAttributes)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(filePayloads) > 0 {
+		response, err := client.UpdateCatalogItemLogo(catalogItemResult.ID, filePayloads, &morpheus.Request{})
 		if err != nil {
+			log.Printf("API FAILURE: %s - %s", response, err)
 			return diag.FromErr(err)
 		}
-
-		var filePayloads []*morpheus.FilePayload
-		filePayload := &morpheus.FilePayload{
-			ParameterName: "logo",
-			FileName:      d.Get("image_name").(string),
-			FileContent:   data,
-		}
-		filePayloads = append(filePayloads, filePayload)
-		client.UpdateCatalogItemLogo(catalogItemResult.ID, filePayloads, &morpheus.Request{})
+		log.Printf("API RESPONSE: %s", response)
 	}
 
 	// Successfully updated resource, now set id
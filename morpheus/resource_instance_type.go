@@ -5,7 +5,6 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
-	"os"
 	"strings"
 
 	"log"
@@ -23,6 +22,7 @@ func resourceInstanceType() *schema.Resource {
 		ReadContext:   resourceInstanceTypeRead,
 		UpdateContext: resourceInstanceTypeUpdate,
 		DeleteContext: resourceInstanceTypeDelete,
+		CustomizeDiff: setLogoImageChecksums(logoImageAttributes),
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -69,6 +69,18 @@ func resourceInstanceType() *schema.Resource {
 				Description: "The file path of the instance type logo image including the file name",
 				Optional:    true,
 			},
+			"logo_content_base64": {
+				Type:          schema.TypeString,
+				Description:   "The base64 encoded content of the instance type logo image, used instead of the image_path so the image does not need to exist on the machine running terraform",
+				Optional:      true,
+				ConflictsWith: []string{"image_path"},
+				RequiredWith:  []string{"image_name"},
+			},
+			"logo_checksum": {
+				Type:        schema.TypeString,
+				Description: "The SHA256 checksum of the instance type logo image, the logo is uploaded again when the content of the image changes",
+				Computed:    true,
+			},
 			"environment_prefix": {
 				Type:        schema.TypeString,
 				Description: "The prefix used for instance environment variables",
@@ -233,19 +245,11 @@ func resourceInstanceTypeCreate(ctx context.Context, d *schema.ResourceData, met
 	result := resp.Result.(*morpheus.CreateInstanceTypeResult)
 	instanceType := result.InstanceType
 
-	if d.Get("image_path") != "" && d.Get("image_name") != "" {
-		data, err := os.ReadFile(d.Get("image_path").(string))
-		if err != nil {
-			return diag.FromErr(err)
-		}
-
-		var filePayloads []*morpheus.FilePayload
-		filePayload := &morpheus.FilePayload{
-			ParameterName: "logo",
-			FileName:      d.Get("image_name").(string),
-			FileContent:   data,
-		}
-		filePayloads = append(filePayloads, filePayload)
+	filePayloads, err := logoImagePayloads(d, logoImageAttributes)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(filePayloads) > 0 {
 		response, err := client.UpdateInstanceTypeLogo(instanceType.ID, filePayloads, &morpheus.Request{})
 		if err != nil {
 			log.Printf("API FAILURE: %s - %s", response, err)
@@ -413,20 +417,17 @@ func resourceInstanceTypeUpdate(ctx context.Context, d *schema.ResourceData, met
 	result := resp.Result.(*morpheus.UpdateInstanceTypeResult)
 	instanceType := result.InstanceType
 
-	if d.HasChange("image_name") || d.HasChange("image_path") {
-		data, err := os.ReadFile(d.Get("image_path").(string))
+	filePayloads, err := logoImagePayloads(d, logoImageAttributes)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(filePayloads) > 0 {
+		response, err := client.UpdateInstanceTypeLogo(instanceType.ID, filePayloads, &morpheus.Request{})
 		if err != nil {
+			log.Printf("API FAILURE: %s - %s", response, err)
 			return diag.FromErr(err)
 		}
-
-		var filePayloads []*morpheus.FilePayload
-		filePayload := &morpheus.FilePayload{
-			ParameterName: "logo",
-			FileName:      d.Get("image_name").(string),
-			FileContent:   data,
-		}
-		filePayloads = append(filePayloads, filePayload)
-		client.UpdateInstanceTypeLogo(instanceType.ID, filePayloads, &morpheus.Request{})
+		log.Printf("API RESPONSE: %s", response)
 	}
 
 	// Successfully updated resource, now set id
@@ -0,0 +1,339 @@
+package morpheus
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIntegration() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a generic Morpheus integration resource for integration types that do not have a dedicated resource",
+		CreateContext: resourceIntegrationCreate,
+		ReadContext:   resourceIntegrationRead,
+		UpdateContext: resourceIntegrationUpdate,
+		DeleteContext: resourceIntegrationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the integration",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the integration",
+				Required:    true,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Description: "The code of the integration type (i.e. - chef, salt, jenkins, veeam, commvault, splunk, slack)",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether the integration is enabled",
+				Optional:    true,
+				Default:     true,
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Description: "The url of the service the integration connects to",
+				Optional:    true,
+				Computed:    true,
+			},
+			"credential_id": {
+				Type:          schema.TypeInt,
+				Description:   "The ID of the credential store entry used for authentication",
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"username", "password"},
+			},
+			"username": {
+				Type:          schema.TypeString,
+				Description:   "The username used for authentication",
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"credential_id"},
+			},
+			"password": {
+				Type:          schema.TypeString,
+				Description:   "The password used for authentication",
+				Optional:      true,
+				Computed:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"credential_id"},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					h := sha256.New()
+					h.Write([]byte(new))
+					sha256_hash := hex.EncodeToString(h.Sum(nil))
+					return strings.EqualFold(old, sha256_hash)
+				},
+				DiffSuppressOnRefresh: true,
+			},
+			"config": {
+				Type:        schema.TypeMap,
+				Description: "The integration type specific configuration, keyed by the config property name",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"sensitive_config": {
+				Type:             schema.TypeMap,
+				Description:      "The integration type specific configuration that contains secrets, the values are stored as hashes in the state",
+				Optional:         true,
+				Sensitive:        true,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				DiffSuppressFunc: suppressHashedMapValueDiffs,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"integration": integrationPayload(d),
+		},
+	}
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method:      "POST",
+		Path:        morpheus.IntegrationsPath,
+		QueryParams: map[string]string{},
+		Body:        req.Body,
+		Result:      &GenericIntegrationResult{},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*GenericIntegrationResult)
+	integrationResult := result.Integration
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(integrationResult.ID))
+	d.Set("sensitive_config", hashIntegrationSensitiveConfig(d))
+
+	resourceIntegrationRead(ctx, d, meta)
+	return diags
+}
+
+func resourceIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method:      "GET",
+		Path:        fmt.Sprintf("%s/%s", morpheus.IntegrationsPath, id),
+		QueryParams: map[string]string{},
+		Result:      &GenericIntegrationResult{},
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	result := resp.Result.(*GenericIntegrationResult)
+	integration := result.Integration
+	d.SetId(int64ToString(integration.ID))
+	d.Set("name", integration.Name)
+	if integration.IntegrationType.Code != "" {
+		d.Set("type", integration.IntegrationType.Code)
+	} else {
+		d.Set("type", integration.Type)
+	}
+	d.Set("enabled", integration.Enabled)
+	d.Set("url", integration.ServiceUrl)
+	if integration.Credential.ID != 0 {
+		d.Set("credential_id", integration.Credential.ID)
+	} else {
+		d.Set("username", integration.ServiceUsername)
+		d.Set("password", integration.ServicePasswordHash)
+	}
+
+	// Only the configured keys are tracked since the integration
+	// type adds its own defaults to the config
+	config := make(map[string]interface{})
+	for k := range d.Get("config").(map[string]interface{}) {
+		if v, ok := integration.Config[k]; ok && v != nil {
+			config[k] = fmt.Sprintf("%v", v)
+		}
+	}
+	d.Set("config", config)
+
+	return diags
+}
+
+func resourceIntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+	id := d.Id()
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"integration": integrationPayload(d),
+		},
+	}
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method:      "PUT",
+		Path:        fmt.Sprintf("%s/%s", morpheus.IntegrationsPath, id),
+		QueryParams: map[string]string{},
+		Body:        req.Body,
+		Result:      &GenericIntegrationResult{},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.Set("sensitive_config", hashIntegrationSensitiveConfig(d))
+
+	return resourceIntegrationRead(ctx, d, meta)
+}
+
+func resourceIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	req := &morpheus.Request{}
+	resp, err := client.DeleteIntegration(toInt64(id), req)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return diag.FromErr(err)
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+func integrationPayload(d *schema.ResourceData) map[string]interface{} {
+	integration := make(map[string]interface{})
+
+	integration["name"] = d.Get("name").(string)
+	integration["type"] = d.Get("type").(string)
+	integration["enabled"] = d.Get("enabled").(bool)
+	if v, ok := d.GetOk("url"); ok {
+		integration["serviceUrl"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("credential_id"); ok {
+		integration["credential"] = map[string]interface{}{
+			"type": "credential",
+			"id":   v.(int),
+		}
+	} else {
+		integration["credential"] = map[string]interface{}{
+			"type": "local",
+		}
+		integration["serviceUsername"] = d.Get("username").(string)
+		// The state holds the hash of the password so it is only sent when changed
+		if d.HasChange("password") {
+			integration["servicePassword"] = d.Get("password").(string)
+		}
+	}
+
+	config := make(map[string]interface{})
+	for k, v := range d.Get("config").(map[string]interface{}) {
+		config[k] = v.(string)
+	}
+	for k, v := range changedIntegrationSensitiveConfig(d) {
+		config[k] = v
+	}
+	integration["config"] = config
+
+	return integration
+}
+
+// changedIntegrationSensitiveConfig returns the sensitive config values that
+// differ from the hashes stored in the state, the unchanged values are
+// omitted so the stored secrets are left untouched
+func changedIntegrationSensitiveConfig(d *schema.ResourceData) map[string]interface{} {
+	o, n := d.GetChange("sensitive_config")
+	oldConfig := o.(map[string]interface{})
+	config := make(map[string]interface{})
+	for k, v := range n.(map[string]interface{}) {
+		if hash, ok := oldConfig[k]; ok && hash == v {
+			continue
+		}
+		config[k] = v.(string)
+	}
+	return config
+}
+
+// hashIntegrationSensitiveConfig returns the sensitive config with the
+// plain text values replaced by their sha256 hashes
+func hashIntegrationSensitiveConfig(d *schema.ResourceData) map[string]interface{} {
+	changed := changedIntegrationSensitiveConfig(d)
+	config := make(map[string]interface{})
+	for k, v := range d.Get("sensitive_config").(map[string]interface{}) {
+		if _, ok := changed[k]; !ok {
+			config[k] = v
+			continue
+		}
+		h := sha256.New()
+		h.Write([]byte(v.(string)))
+		config[k] = hex.EncodeToString(h.Sum(nil))
+	}
+	return config
+}
+
+type GenericIntegrationResult struct {
+	Success     bool              `json:"success"`
+	Message     string            `json:"msg"`
+	Errors      map[string]string `json:"errors"`
+	Integration struct {
+		ID              int64  `json:"id"`
+		Name            string `json:"name"`
+		Type            string `json:"type"`
+		Enabled         bool   `json:"enabled"`
+		IntegrationType struct {
+			Code string `json:"code"`
+		} `json:"integrationType"`
+		ServiceUrl          string `json:"serviceUrl"`
+		ServiceUsername     string `json:"serviceUsername"`
+		ServicePasswordHash string `json:"passwordHash"`
+		Credential          struct {
+			ID int64 `json:"id"`
+		} `json:"credential"`
+		Config map[string]interface{} `json:"config"`
+	} `json:"integration"`
+}
@@ -20,6 +20,7 @@ func resourceKubernetesAppBlueprint() *schema.Resource {
 		ReadContext:   resourceKubernetesAppBlueprintRead,
 		UpdateContext: resourceKubernetesAppBlueprintUpdate,
 		DeleteContext: resourceKubernetesAppBlueprintDelete,
+		CustomizeDiff: validateTemplateContent("blueprint_content", map[string]templateContentValidator{"yaml": kubernetesTemplateContent}),
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -50,7 +51,7 @@ func resourceKubernetesAppBlueprint() *schema.Resource {
 			},
 			"blueprint_content": {
 				Type:        schema.TypeString,
-				Description: "The content of the kubernetes app blueprint. Used when the yaml source type is specified",
+				Description: "The content of the kubernetes app blueprint. Used when the yaml source type is specified, each yaml document must be a kubernetes object with the apiVersion and kind fields",
 				Optional:    true,
 				StateFunc: func(val interface{}) string {
 					return strings.TrimSuffix(val.(string), "\n")
@@ -21,6 +21,7 @@ func resourceKubernetesSpecTemplate() *schema.Resource {
 		ReadContext:   resourceKubernetesSpecTemplateRead,
 		UpdateContext: resourceKubernetesSpecTemplateUpdate,
 		DeleteContext: resourceKubernetesSpecTemplateDelete,
+		CustomizeDiff: validateTemplateContent("spec_content", map[string]templateContentValidator{"local": kubernetesTemplateContent}),
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -41,7 +42,7 @@ func resourceKubernetesSpecTemplate() *schema.Resource {
 			},
 			"spec_content": {
 				Type:        schema.TypeString,
-				Description: "The content of the kubernetes spec template. Used when the local source type is specified",
+				Description: "The content of the kubernetes spec template. Used when the local source type is specified, each yaml document must be a kubernetes object with the apiVersion and kind fields",
 				Optional:    true,
 				StateFunc: func(val interface{}) string {
 					return strings.TrimSuffix(val.(string), "\n")
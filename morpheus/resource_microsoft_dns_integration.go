@@ -0,0 +1,256 @@
+package morpheus
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceMicrosoftDNSIntegration() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Microsoft DNS integration resource",
+		CreateContext: resourceMicrosoftDNSIntegrationCreate,
+		ReadContext:   resourceMicrosoftDNSIntegrationRead,
+		UpdateContext: resourceMicrosoftDNSIntegrationUpdate,
+		DeleteContext: resourceMicrosoftDNSIntegrationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the Microsoft DNS integration",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the Microsoft DNS integration",
+				Required:    true,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether the Microsoft DNS integration is enabled",
+				Optional:    true,
+				Default:     true,
+			},
+			"server": {
+				Type:        schema.TypeString,
+				Description: "The hostname or IP address of the Windows server used to connect to the DNS service",
+				Required:    true,
+			},
+			"dns_server": {
+				Type:        schema.TypeString,
+				Description: "The hostname or IP address of the DNS server when it differs from the server used to connect",
+				Optional:    true,
+				Computed:    true,
+			},
+			"credential_id": {
+				Type:          schema.TypeInt,
+				Description:   "The ID of the credential store entry used for authentication",
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"username", "password"},
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Description: "The username of the account used to authenticate to the DNS server",
+				Optional:    true,
+				Computed:    true,
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Description: "The password of the account used to authenticate to the DNS server",
+				Optional:    true,
+				Computed:    true,
+				Sensitive:   true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					h := sha256.New()
+					h.Write([]byte(new))
+					sha256_hash := hex.EncodeToString(h.Sum(nil))
+					return strings.EqualFold(old, sha256_hash)
+				},
+				DiffSuppressOnRefresh: true,
+			},
+			"zone_filter": {
+				Type:        schema.TypeString,
+				Description: "A filter used to limit the DNS zones that are synced from the DNS server",
+				Optional:    true,
+				Computed:    true,
+			},
+			"create_pointer_records": {
+				Type:        schema.TypeBool,
+				Description: "Whether to create PTR records when A records are created",
+				Optional:    true,
+				Computed:    true,
+			},
+			"inventory_existing": {
+				Type:        schema.TypeBool,
+				Description: "Whether to inventory the existing DNS records",
+				Optional:    true,
+				Computed:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceMicrosoftDNSIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"integration": microsoftDNSIntegrationPayload(d),
+		},
+	}
+
+	resp, err := client.CreateIntegration(req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.CreateIntegrationResult)
+	integrationResult := result.Integration
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(integrationResult.ID))
+
+	resourceMicrosoftDNSIntegrationRead(ctx, d, meta)
+	return diags
+}
+
+func resourceMicrosoftDNSIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	integration, resp, err := getNetworkIntegration(client, d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	d.SetId(int64ToString(integration.ID))
+	d.Set("name", integration.Name)
+	d.Set("enabled", integration.Enabled)
+	d.Set("server", integration.serviceURL())
+	d.Set("credential_id", integration.Credential.ID)
+	d.Set("username", integration.ServiceUsername)
+	d.Set("password", integration.PasswordHash)
+	d.Set("zone_filter", integration.ZoneFilter)
+	if dnsServer, ok := integration.Config["dnsServer"].(string); ok {
+		d.Set("dns_server", dnsServer)
+	}
+	d.Set("create_pointer_records", networkIntegrationConfigBool(integration.Config["createPointer"]))
+	d.Set("inventory_existing", networkIntegrationConfigBool(integration.Config["inventoryExisting"]))
+	return diags
+}
+
+func resourceMicrosoftDNSIntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+	id := d.Id()
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"integration": microsoftDNSIntegrationPayload(d),
+		},
+	}
+
+	resp, err := client.UpdateIntegration(toInt64(id), req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	result := resp.Result.(*morpheus.UpdateIntegrationResult)
+	integrationResult := result.Integration
+
+	// Successfully updated resource, now set id
+	// err, it should not have changed though..
+	d.SetId(int64ToString(integrationResult.ID))
+	return resourceMicrosoftDNSIntegrationRead(ctx, d, meta)
+}
+
+func resourceMicrosoftDNSIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	req := &morpheus.Request{}
+	resp, err := client.DeleteIntegration(toInt64(id), req)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return diag.FromErr(err)
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+func microsoftDNSIntegrationPayload(d *schema.ResourceData) map[string]interface{} {
+	integration := make(map[string]interface{})
+	integration["name"] = d.Get("name").(string)
+	integration["enabled"] = d.Get("enabled").(bool)
+	integration["type"] = "microsoftDns"
+	integration["serviceUrl"] = d.Get("server").(string)
+	integration["zoneFilter"] = d.Get("zone_filter").(string)
+
+	if d.Get("credential_id").(int) != 0 {
+		credential := make(map[string]interface{})
+		credential["type"] = "username-password"
+		credential["id"] = d.Get("credential_id").(int)
+		integration["credential"] = credential
+	} else {
+		credential := make(map[string]interface{})
+		credential["type"] = "local"
+		integration["credential"] = credential
+		integration["serviceUsername"] = d.Get("username").(string)
+		// The state holds the hash of the password so it is only sent when changed
+		if d.HasChange("password") {
+			integration["servicePassword"] = d.Get("password").(string)
+		}
+	}
+
+	config := make(map[string]interface{})
+	config["dnsServer"] = d.Get("dns_server").(string)
+	if d.Get("create_pointer_records").(bool) {
+		config["createPointer"] = "on"
+	} else {
+		config["createPointer"] = "off"
+	}
+	if d.Get("inventory_existing").(bool) {
+		config["inventoryExisting"] = "on"
+	} else {
+		config["inventoryExisting"] = "off"
+	}
+	integration["config"] = config
+
+	return integration
+}
@@ -0,0 +1,290 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetworkDomainRecord() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus network domain record resource for managing DNS records in domains backed by a DNS integration",
+		CreateContext: resourceNetworkDomainRecordCreate,
+		ReadContext:   resourceNetworkDomainRecordRead,
+		DeleteContext: resourceNetworkDomainRecordDelete,
+		CustomizeDiff: validateNetworkDomainRecordContent,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the network domain record",
+				Computed:    true,
+			},
+			"network_domain_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the network domain the record is created in",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the network domain record (i.e. - www)",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"fqdn": {
+				Type:        schema.TypeString,
+				Description: "The fully qualified domain name of the network domain record",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Description:  "The type of the network domain record (A, AAAA, CNAME, PTR, TXT)",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"A", "AAAA", "CNAME", "PTR", "TXT"}, false),
+			},
+			"content": {
+				Type:        schema.TypeString,
+				Description: "The content of the network domain record such as the IP address for A and AAAA records or the target hostname for CNAME and PTR records",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"ttl": {
+				Type:         schema.TypeInt,
+				Description:  "The time to live in seconds of the network domain record",
+				Optional:     true,
+				ForceNew:     true,
+				Default:      86400,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"comments": {
+				Type:        schema.TypeString,
+				Description: "The comments associated with the network domain record",
+				Optional:    true,
+				ForceNew:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceNetworkDomainRecordImport,
+		},
+	}
+}
+
+func resourceNetworkDomainRecordCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	domainId := d.Get("network_domain_id").(int)
+
+	record := make(map[string]interface{})
+	record["name"] = d.Get("name").(string)
+	record["fqdn"] = d.Get("fqdn").(string)
+	record["type"] = d.Get("type").(string)
+	record["content"] = d.Get("content").(string)
+	record["ttl"] = d.Get("ttl").(int)
+	record["comments"] = d.Get("comments").(string)
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"networkDomainRecord": record,
+		},
+	}
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method:      "POST",
+		Path:        fmt.Sprintf("%s/%d/records", morpheus.NetworkDomainsPath, domainId),
+		QueryParams: map[string]string{},
+		Body:        req.Body,
+		Result:      &NetworkDomainRecordResult{},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*NetworkDomainRecordResult)
+	if result.NetworkDomainRecord == nil {
+		return diag.Errorf("Network domain record not found in response data.") // should not happen
+	}
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(result.NetworkDomainRecord.ID))
+
+	resourceNetworkDomainRecordRead(ctx, d, meta)
+	return diags
+}
+
+func resourceNetworkDomainRecordRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	domainId := d.Get("network_domain_id").(int)
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method:      "GET",
+		Path:        fmt.Sprintf("%s/%d/records/%d", morpheus.NetworkDomainsPath, domainId, toInt64(id)),
+		QueryParams: map[string]string{},
+		Result:      &NetworkDomainRecordResult{},
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	result := resp.Result.(*NetworkDomainRecordResult)
+	record := result.NetworkDomainRecord
+	if record == nil {
+		return diag.Errorf("Network domain record not found in response data.") // should not happen
+	}
+	d.SetId(int64ToString(record.ID))
+	if record.NetworkDomain.ID != 0 {
+		d.Set("network_domain_id", record.NetworkDomain.ID)
+	}
+	d.Set("name", record.Name)
+	d.Set("fqdn", record.Fqdn)
+	d.Set("type", strings.ToUpper(record.Type))
+	d.Set("content", record.Content)
+	d.Set("ttl", record.TTL)
+	d.Set("comments", record.Comments)
+	return diags
+}
+
+func resourceNetworkDomainRecordDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	domainId := d.Get("network_domain_id").(int)
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method:      "DELETE",
+		Path:        fmt.Sprintf("%s/%d/records/%d", morpheus.NetworkDomainsPath, domainId, toInt64(id)),
+		QueryParams: map[string]string{},
+		Result:      &morpheus.DeleteResult{},
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+// resourceNetworkDomainRecordImport accepts an import id in the format of
+// <network_domain_id>:<record_id> since records are nested under the domain
+func resourceNetworkDomainRecordImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected format of id (%s), expected network_domain_id:record_id", d.Id())
+	}
+	d.Set("network_domain_id", int(stringToInt64(parts[0])))
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, nil
+}
+
+// validateNetworkDomainRecordContent ensures the record content matches
+// the record type so invalid records are caught during the plan
+func validateNetworkDomainRecordContent(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("type") || !d.NewValueKnown("content") {
+		return nil
+	}
+	recordType := d.Get("type").(string)
+	content := d.Get("content").(string)
+
+	switch recordType {
+	case "A":
+		ip := net.ParseIP(content)
+		if ip == nil || ip.To4() == nil {
+			return fmt.Errorf("content must be a valid IPv4 address for an A record, got: %s", content)
+		}
+	case "AAAA":
+		ip := net.ParseIP(content)
+		if ip == nil || ip.To4() != nil {
+			return fmt.Errorf("content must be a valid IPv6 address for an AAAA record, got: %s", content)
+		}
+	case "CNAME", "PTR":
+		if !isValidDomainRecordHostname(content) {
+			return fmt.Errorf("content must be a valid hostname for a %s record, got: %s", recordType, content)
+		}
+	case "TXT":
+		if content == "" {
+			return fmt.Errorf("content must not be empty for a TXT record")
+		}
+	}
+	return nil
+}
+
+func isValidDomainRecordHostname(hostname string) bool {
+	hostname = strings.TrimSuffix(hostname, ".")
+	if hostname == "" || len(hostname) > 253 {
+		return false
+	}
+	for _, label := range strings.Split(hostname, ".") {
+		if label == "" || len(label) > 63 {
+			return false
+		}
+		if strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+			return false
+		}
+		for _, c := range label {
+			if !(c >= 'a' && c <= 'z') && !(c >= 'A' && c <= 'Z') && !(c >= '0' && c <= '9') && c != '-' && c != '_' {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+type NetworkDomainRecordResult struct {
+	Success             bool                 `json:"success"`
+	Message             string               `json:"msg"`
+	Errors              map[string]string    `json:"errors"`
+	NetworkDomainRecord *NetworkDomainRecord `json:"networkDomainRecord"`
+}
+
+type NetworkDomainRecord struct {
+	ID            int64  `json:"id"`
+	Name          string `json:"name"`
+	Fqdn          string `json:"fqdn"`
+	Type          string `json:"type"`
+	Content       string `json:"content"`
+	TTL           int64  `json:"ttl"`
+	Comments      string `json:"comments"`
+	NetworkDomain struct {
+		ID   int64  `json:"id"`
+		Name string `json:"name"`
+	} `json:"networkDomain"`
+}
@@ -0,0 +1,310 @@
+package morpheus
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetworkProxy() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus network proxy resource",
+		CreateContext: resourceNetworkProxyCreate,
+		ReadContext:   resourceNetworkProxyRead,
+		UpdateContext: resourceNetworkProxyUpdate,
+		DeleteContext: resourceNetworkProxyDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the network proxy",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the network proxy",
+				Required:    true,
+			},
+			"host": {
+				Type:        schema.TypeString,
+				Description: "The hostname or IP address of the network proxy",
+				Required:    true,
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Description:  "The port of the network proxy",
+				Required:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Description: "The username of the account used to authenticate to the network proxy",
+				Optional:    true,
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Description: "The password of the account used to authenticate to the network proxy",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"domain": {
+				Type:        schema.TypeString,
+				Description: "The domain used for NTLM authentication to the network proxy",
+				Optional:    true,
+			},
+			"workstation": {
+				Type:        schema.TypeString,
+				Description: "The workstation used for NTLM authentication to the network proxy",
+				Optional:    true,
+			},
+			"exclusions": {
+				Type:        schema.TypeList,
+				Description: "A list of hosts, domains or CIDR ranges that bypass the network proxy",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"visibility": {
+				Type:         schema.TypeString,
+				Description:  "Determines whether the network proxy is visible in sub-tenants or not",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"private", "public"}, false),
+				Default:      "private",
+			},
+			"tenant_id": {
+				Type:        schema.TypeInt,
+				Description: "The id of the morpheus tenant the network proxy is assigned to",
+				Optional:    true,
+				Computed:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetworkProxyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"networkProxy": networkProxyPayload(d),
+		},
+	}
+
+	resp, err := client.CreateNetworkProxy(req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.CreateNetworkProxyResult)
+	networkProxy := result.NetworkProxy
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(networkProxy.ID))
+
+	resourceNetworkProxyRead(ctx, d, meta)
+	return diags
+}
+
+func resourceNetworkProxyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	name := d.Get("name").(string)
+
+	// lookup by name if we do not have an id yet
+	var resp *morpheus.Response
+	var err error
+	if id == "" && name != "" {
+		resp, err = client.FindNetworkProxyByName(name)
+	} else if id != "" {
+		resp, err = client.GetNetworkProxy(toInt64(id), &morpheus.Request{})
+	} else {
+		return diag.Errorf("Network proxy cannot be read without name or id")
+	}
+
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	result := resp.Result.(*morpheus.GetNetworkProxyResult)
+	networkProxy := result.NetworkProxy
+	if networkProxy == nil {
+		return diag.Errorf("Network proxy not found in response data.") // should not happen
+	}
+	d.SetId(int64ToString(networkProxy.ID))
+	d.Set("name", networkProxy.Name)
+	d.Set("host", networkProxy.ProxyHost)
+	d.Set("port", networkProxy.ProxyPort)
+	d.Set("username", networkProxy.ProxyUser)
+	d.Set("domain", networkProxy.ProxyDomain)
+	d.Set("workstation", networkProxy.ProxyWorkstation)
+	d.Set("visibility", networkProxy.Visibility)
+	d.Set("tenant_id", networkProxy.Account.ID)
+
+	// The exclusions are not part of the sdk network proxy structure
+	var exclusionsPayload NetworkProxyExclusions
+	if err := json.Unmarshal(resp.Body, &exclusionsPayload); err == nil && exclusionsPayload.NetworkProxy.NoProxy != nil {
+		var exclusions []string
+		for _, exclusion := range strings.Split(*exclusionsPayload.NetworkProxy.NoProxy, ",") {
+			if strings.TrimSpace(exclusion) != "" {
+				exclusions = append(exclusions, strings.TrimSpace(exclusion))
+			}
+		}
+		d.Set("exclusions", exclusions)
+	}
+	return diags
+}
+
+func resourceNetworkProxyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+	id := d.Id()
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"networkProxy": networkProxyPayload(d),
+		},
+	}
+
+	resp, err := client.UpdateNetworkProxy(toInt64(id), req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	result := resp.Result.(*morpheus.UpdateNetworkProxyResult)
+	networkProxy := result.NetworkProxy
+
+	// Successfully updated resource, now set id
+	// err, it should not have changed though..
+	d.SetId(int64ToString(networkProxy.ID))
+	return resourceNetworkProxyRead(ctx, d, meta)
+}
+
+func resourceNetworkProxyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	req := &morpheus.Request{}
+	resp, err := client.DeleteNetworkProxy(toInt64(id), req)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+func networkProxyPayload(d *schema.ResourceData) map[string]interface{} {
+	networkProxy := make(map[string]interface{})
+	networkProxy["name"] = d.Get("name").(string)
+	networkProxy["proxyHost"] = d.Get("host").(string)
+	networkProxy["proxyPort"] = d.Get("port").(int)
+	networkProxy["proxyUser"] = d.Get("username").(string)
+	networkProxy["proxyPassword"] = d.Get("password").(string)
+	networkProxy["proxyDomain"] = d.Get("domain").(string)
+	networkProxy["proxyWorkstation"] = d.Get("workstation").(string)
+	networkProxy["visibility"] = d.Get("visibility").(string)
+
+	var exclusions []string
+	for _, exclusion := range d.Get("exclusions").([]interface{}) {
+		exclusions = append(exclusions, exclusion.(string))
+	}
+	networkProxy["noProxy"] = strings.Join(exclusions, ",")
+
+	if d.Get("tenant_id").(int) != 0 {
+		account := make(map[string]interface{})
+		account["id"] = d.Get("tenant_id").(int)
+		networkProxy["account"] = account
+	}
+	return networkProxy
+}
+
+type NetworkProxyExclusions struct {
+	NetworkProxy struct {
+		NoProxy *string `json:"noProxy"`
+	} `json:"networkProxy"`
+}
+
+// cloudNetworkProxyPayload assigns the api and provisioning network proxies
+// configured on a cloud resource to the cloud payload
+func cloudNetworkProxyPayload(d *schema.ResourceData, cloud map[string]interface{}) {
+	if d.Get("api_proxy_id").(int) != 0 {
+		apiProxy := make(map[string]interface{})
+		apiProxy["id"] = d.Get("api_proxy_id").(int)
+		cloud["apiProxy"] = apiProxy
+	} else {
+		cloud["apiProxy"] = nil
+	}
+	if d.Get("provisioning_proxy_id").(int) != 0 {
+		provisioningProxy := make(map[string]interface{})
+		provisioningProxy["id"] = d.Get("provisioning_proxy_id").(int)
+		cloud["provisioningProxy"] = provisioningProxy
+	} else {
+		cloud["provisioningProxy"] = nil
+	}
+}
+
+// setCloudNetworkProxies reads the network proxies assigned to a cloud
+// since they are not part of the sdk cloud structure
+func setCloudNetworkProxies(d *schema.ResourceData, body []byte) {
+	var cloudProxies CloudNetworkProxies
+	if err := json.Unmarshal(body, &cloudProxies); err != nil {
+		log.Printf("Unable to parse cloud network proxies: %s", err)
+		return
+	}
+	var apiProxyId, provisioningProxyId int64
+	if cloudProxies.Zone.ApiProxy != nil {
+		apiProxyId = cloudProxies.Zone.ApiProxy.ID
+	}
+	if cloudProxies.Zone.ProvisioningProxy != nil {
+		provisioningProxyId = cloudProxies.Zone.ProvisioningProxy.ID
+	}
+	d.Set("api_proxy_id", apiProxyId)
+	d.Set("provisioning_proxy_id", provisioningProxyId)
+}
+
+type CloudNetworkProxies struct {
+	Zone struct {
+		ApiProxy *struct {
+			ID int64 `json:"id"`
+		} `json:"apiProxy"`
+		ProvisioningProxy *struct {
+			ID int64 `json:"id"`
+		} `json:"provisioningProxy"`
+	} `json:"zone"`
+}
@@ -0,0 +1,666 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var (
+	// NetworkRoutersPath is the API endpoint for network routers
+	NetworkRoutersPath = "/api/networks/routers"
+)
+
+func resourceNetworkRouter() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus network router resource for clouds that support routers",
+		CreateContext: resourceNetworkRouterCreate,
+		ReadContext:   resourceNetworkRouterRead,
+		UpdateContext: resourceNetworkRouterUpdate,
+		DeleteContext: resourceNetworkRouterDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the network router",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the network router",
+				Required:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "The description of the network router",
+				Optional:    true,
+			},
+			"type_code": {
+				Type:        schema.TypeString,
+				Description: "The code of the network router type (i.e. - openstackRouter)",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"cloud_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the cloud the network router is created in",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"group_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the group the network router is associated with",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"network_server_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the network server (i.e. - NSX manager) that manages the network router",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether the network router is enabled",
+				Optional:    true,
+				Default:     true,
+			},
+			"config": {
+				Type:        schema.TypeMap,
+				Description: "Additional router type specific configuration settings",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"interface": {
+				Type:        schema.TypeList,
+				Description: "The interfaces attached to the network router",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The name of the router interface",
+							Required:    true,
+						},
+						"network_id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of the network the router interface is attached to",
+							Required:    true,
+						},
+						"ip_address": {
+							Type:        schema.TypeString,
+							Description: "The IP address of the router interface",
+							Optional:    true,
+						},
+						"interface_type": {
+							Type:         schema.TypeString,
+							Description:  "The type of the router interface (internal, external)",
+							Optional:     true,
+							Default:      "internal",
+							ValidateFunc: validation.StringInSlice([]string{"internal", "external"}, false),
+						},
+					},
+				},
+			},
+			"route": {
+				Type:        schema.TypeList,
+				Description: "The static routes of the network router, the routes are matched to the existing routes of the router by name so only the routes that change are updated",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The name of the route",
+							Required:    true,
+						},
+						"destination": {
+							Type:         schema.TypeString,
+							Description:  "The destination network of the route in CIDR notation (i.e. - 10.0.0.0/24)",
+							Required:     true,
+							ValidateFunc: validation.IsCIDR,
+						},
+						"next_hop": {
+							Type:        schema.TypeString,
+							Description: "The next hop address of the route",
+							Required:    true,
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Description: "Whether the route is enabled",
+							Optional:    true,
+							Default:     true,
+						},
+					},
+				},
+			},
+			"nat": {
+				Type:        schema.TypeList,
+				Description: "The NAT rules of the network router, the rules are matched to the existing rules of the router by name so only the rules that change are updated",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The name of the NAT rule",
+							Required:    true,
+						},
+						"action": {
+							Type:         schema.TypeString,
+							Description:  "The action of the NAT rule (SNAT, DNAT, NO_SNAT, NO_DNAT, REFLEXIVE)",
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"SNAT", "DNAT", "NO_SNAT", "NO_DNAT", "REFLEXIVE"}, false),
+						},
+						"source_network": {
+							Type:        schema.TypeString,
+							Description: "The source network of the NAT rule",
+							Optional:    true,
+						},
+						"destination_network": {
+							Type:        schema.TypeString,
+							Description: "The destination network of the NAT rule",
+							Optional:    true,
+						},
+						"translated_network": {
+							Type:        schema.TypeString,
+							Description: "The translated network of the NAT rule",
+							Required:    true,
+						},
+						"translated_ports": {
+							Type:        schema.TypeString,
+							Description: "The translated ports of the NAT rule",
+							Optional:    true,
+						},
+						"priority": {
+							Type:        schema.TypeInt,
+							Description: "The priority of the NAT rule",
+							Optional:    true,
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Description: "Whether the NAT rule is enabled",
+							Optional:    true,
+							Default:     true,
+						},
+					},
+				},
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetworkRouterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	router := networkRouterPayload(d)
+
+	routerType := make(map[string]interface{})
+	routerType["code"] = d.Get("type_code").(string)
+	router["type"] = routerType
+
+	zone := make(map[string]interface{})
+	zone["id"] = d.Get("cloud_id").(int)
+	router["zone"] = zone
+
+	if d.Get("group_id").(int) != 0 {
+		site := make(map[string]interface{})
+		site["id"] = d.Get("group_id").(int)
+		router["site"] = site
+	}
+
+	if d.Get("network_server_id").(int) != 0 {
+		networkServer := make(map[string]interface{})
+		networkServer["id"] = d.Get("network_server_id").(int)
+		router["networkServer"] = networkServer
+	}
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"networkRouter": router,
+		},
+	}
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method:      "POST",
+		Path:        NetworkRoutersPath,
+		QueryParams: map[string]string{},
+		Body:        req.Body,
+		Result:      &NetworkRouterResult{},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*NetworkRouterResult)
+	if result.NetworkRouter == nil {
+		return diag.Errorf("Network router not found in response data.") // should not happen
+	}
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(result.NetworkRouter.ID))
+
+	if err := syncNetworkRouterChildren(client, result.NetworkRouter.ID, "routes", "route", nil, d.Get("route").([]interface{}), networkRouterRoutePayload); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := syncNetworkRouterChildren(client, result.NetworkRouter.ID, "nats", "networkRouterNAT", nil, d.Get("nat").([]interface{}), networkRouterNatPayload); err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceNetworkRouterRead(ctx, d, meta)
+	return diags
+}
+
+func resourceNetworkRouterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method:      "GET",
+		Path:        fmt.Sprintf("%s/%d", NetworkRoutersPath, toInt64(id)),
+		QueryParams: map[string]string{},
+		Result:      &NetworkRouterResult{},
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	result := resp.Result.(*NetworkRouterResult)
+	router := result.NetworkRouter
+	if router == nil {
+		return diag.Errorf("Network router not found in response data.") // should not happen
+	}
+	d.SetId(int64ToString(router.ID))
+	d.Set("name", router.Name)
+	d.Set("description", router.Description)
+	d.Set("enabled", router.Enabled)
+	if router.Type.Code != "" {
+		d.Set("type_code", router.Type.Code)
+	}
+	if router.Zone.ID != 0 {
+		d.Set("cloud_id", router.Zone.ID)
+	}
+	d.Set("group_id", router.Site.ID)
+	d.Set("network_server_id", router.NetworkServer.ID)
+
+	// Only the configured settings are tracked since the appliance
+	// returns the settings of the router type as well
+	config := make(map[string]interface{})
+	for k := range d.Get("config").(map[string]interface{}) {
+		if v, ok := router.Config[k]; ok && v != nil {
+			config[k] = fmt.Sprintf("%v", v)
+		}
+	}
+	d.Set("config", config)
+
+	var interfaces []map[string]interface{}
+	for _, routerInterface := range router.Interfaces {
+		row := make(map[string]interface{})
+		row["name"] = routerInterface.Name
+		row["network_id"] = routerInterface.Network.ID
+		row["ip_address"] = routerInterface.IPAddress
+		row["interface_type"] = routerInterface.InterfaceType
+		interfaces = append(interfaces, row)
+	}
+	d.Set("interface", interfaces)
+
+	d.Set("route", orderNetworkRouterChildren(d.Get("route").([]interface{}), networkRouterRoutes(router)))
+	d.Set("nat", orderNetworkRouterChildren(d.Get("nat").([]interface{}), networkRouterNats(router)))
+	return diags
+}
+
+func resourceNetworkRouterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+	id := d.Id()
+
+	if d.HasChanges("name", "description", "enabled", "config", "interface") {
+		req := &morpheus.Request{
+			Body: map[string]interface{}{
+				"networkRouter": networkRouterPayload(d),
+			},
+		}
+
+		resp, err := client.Execute(&morpheus.Request{
+			Method:      "PUT",
+			Path:        fmt.Sprintf("%s/%d", NetworkRoutersPath, toInt64(id)),
+			QueryParams: map[string]string{},
+			Body:        req.Body,
+			Result:      &NetworkRouterResult{},
+		})
+		if err != nil {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+		log.Printf("API RESPONSE: %s", resp)
+	}
+
+	// Routes and nat rules are managed through their own endpoints so
+	// they are compared with the rules currently attached to the router
+	if d.HasChanges("route", "nat") {
+		router, err := getNetworkRouter(client, toInt64(id))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if d.HasChange("route") {
+			if err := syncNetworkRouterChildren(client, toInt64(id), "routes", "route", networkRouterRoutes(router), d.Get("route").([]interface{}), networkRouterRoutePayload); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if d.HasChange("nat") {
+			if err := syncNetworkRouterChildren(client, toInt64(id), "nats", "networkRouterNAT", networkRouterNats(router), d.Get("nat").([]interface{}), networkRouterNatPayload); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return resourceNetworkRouterRead(ctx, d, meta)
+}
+
+func resourceNetworkRouterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	resp, err := client.Execute(&morpheus.Request{
+		Method:      "DELETE",
+		Path:        fmt.Sprintf("%s/%d", NetworkRoutersPath, toInt64(id)),
+		QueryParams: map[string]string{},
+		Result:      &morpheus.DeleteResult{},
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+func networkRouterPayload(d *schema.ResourceData) map[string]interface{} {
+	router := make(map[string]interface{})
+	router["name"] = d.Get("name").(string)
+	router["description"] = d.Get("description").(string)
+	router["enabled"] = d.Get("enabled").(bool)
+	router["config"] = d.Get("config").(map[string]interface{})
+
+	var interfaces []map[string]interface{}
+	for _, v := range d.Get("interface").([]interface{}) {
+		interfaceConfig := v.(map[string]interface{})
+		row := make(map[string]interface{})
+		row["name"] = interfaceConfig["name"].(string)
+		row["network"] = map[string]interface{}{
+			"id": interfaceConfig["network_id"].(int),
+		}
+		row["ipAddress"] = interfaceConfig["ip_address"].(string)
+		row["interfaceType"] = interfaceConfig["interface_type"].(string)
+		interfaces = append(interfaces, row)
+	}
+	router["interfaces"] = interfaces
+	return router
+}
+
+func networkRouterRoutePayload(routeConfig map[string]interface{}) map[string]interface{} {
+	route := make(map[string]interface{})
+	route["name"] = routeConfig["name"].(string)
+	// The api names the destination network source and the next hop destination
+	route["source"] = routeConfig["destination"].(string)
+	route["destination"] = routeConfig["next_hop"].(string)
+	route["enabled"] = routeConfig["enabled"].(bool)
+	return route
+}
+
+func networkRouterNatPayload(natConfig map[string]interface{}) map[string]interface{} {
+	nat := make(map[string]interface{})
+	nat["name"] = natConfig["name"].(string)
+	nat["action"] = natConfig["action"].(string)
+	nat["sourceNetwork"] = natConfig["source_network"].(string)
+	nat["destinationNetwork"] = natConfig["destination_network"].(string)
+	nat["translatedNetwork"] = natConfig["translated_network"].(string)
+	nat["translatedPorts"] = natConfig["translated_ports"].(string)
+	nat["priority"] = natConfig["priority"].(int)
+	nat["enabled"] = natConfig["enabled"].(bool)
+	return nat
+}
+
+// networkRouterChild is a route or nat rule of the router along with its
+// values in the form of the configuration block
+type networkRouterChild struct {
+	id     int64
+	config map[string]interface{}
+}
+
+func networkRouterRoutes(router *NetworkRouter) []networkRouterChild {
+	var routes []networkRouterChild
+	for _, route := range router.Routes {
+		row := make(map[string]interface{})
+		row["name"] = route.Name
+		// The api names the destination network source and the next hop destination
+		row["destination"] = route.Source
+		row["next_hop"] = route.Destination
+		row["enabled"] = route.Enabled
+		routes = append(routes, networkRouterChild{id: route.ID, config: row})
+	}
+	return routes
+}
+
+func networkRouterNats(router *NetworkRouter) []networkRouterChild {
+	var nats []networkRouterChild
+	for _, nat := range router.Nats {
+		row := make(map[string]interface{})
+		row["name"] = nat.Name
+		row["action"] = nat.Action
+		row["source_network"] = nat.SourceNetwork
+		row["destination_network"] = nat.DestinationNetwork
+		row["translated_network"] = nat.TranslatedNetwork
+		row["translated_ports"] = nat.TranslatedPorts
+		row["priority"] = int(nat.Priority)
+		row["enabled"] = nat.Enabled
+		nats = append(nats, networkRouterChild{id: nat.ID, config: row})
+	}
+	return nats
+}
+
+// matchNetworkRouterChildren pairs each configured block with the first
+// unmatched route or nat rule of the same name, nil is returned for the
+// blocks without a match along with the rules that were not matched
+func matchNetworkRouterChildren(configured []interface{}, existing []networkRouterChild) ([]*networkRouterChild, []networkRouterChild) {
+	matched := make([]bool, len(existing))
+	matches := make([]*networkRouterChild, len(configured))
+	for i, v := range configured {
+		childConfig := v.(map[string]interface{})
+		for j := range existing {
+			if !matched[j] && existing[j].config["name"] == childConfig["name"] {
+				matched[j] = true
+				matches[i] = &existing[j]
+				break
+			}
+		}
+	}
+	var unmatched []networkRouterChild
+	for j, child := range existing {
+		if !matched[j] {
+			unmatched = append(unmatched, child)
+		}
+	}
+	return matches, unmatched
+}
+
+// orderNetworkRouterChildren returns the routes or nat rules in the order of
+// the configured blocks, the rules that are not configured are appended in
+// the order they were created
+func orderNetworkRouterChildren(configured []interface{}, existing []networkRouterChild) []map[string]interface{} {
+	matches, unmatched := matchNetworkRouterChildren(configured, existing)
+	var children []map[string]interface{}
+	for _, child := range matches {
+		if child != nil {
+			children = append(children, child.config)
+		}
+	}
+	sort.Slice(unmatched, func(i, j int) bool { return unmatched[i].id < unmatched[j].id })
+	for _, child := range unmatched {
+		children = append(children, child.config)
+	}
+	return children
+}
+
+// syncNetworkRouterChildren updates the routes or nat rules of the router to
+// match the configured blocks, only the rules that changed are updated, the
+// new rules are created and the rules that are no longer configured are
+// deleted last so the remaining traffic is not interrupted
+func syncNetworkRouterChildren(client *morpheus.Client, routerId int64, childPath string, childKey string, existing []networkRouterChild, configured []interface{}, payload func(map[string]interface{}) map[string]interface{}) error {
+	matches, unmatched := matchNetworkRouterChildren(configured, existing)
+	for i, v := range configured {
+		childConfig := v.(map[string]interface{})
+		method := "POST"
+		path := fmt.Sprintf("%s/%d/%s", NetworkRoutersPath, routerId, childPath)
+		if child := matches[i]; child != nil {
+			if reflect.DeepEqual(child.config, childConfig) {
+				continue
+			}
+			method = "PUT"
+			path = fmt.Sprintf("%s/%d/%s/%d", NetworkRoutersPath, routerId, childPath, child.id)
+		}
+		resp, err := client.Execute(&morpheus.Request{
+			Method:      method,
+			Path:        path,
+			QueryParams: map[string]string{},
+			Body: map[string]interface{}{
+				childKey: payload(childConfig),
+			},
+			Result: &morpheus.StandardResult{},
+		})
+		if err != nil {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return err
+		}
+		log.Printf("API RESPONSE: %s", resp)
+	}
+	for _, child := range unmatched {
+		resp, err := client.Execute(&morpheus.Request{
+			Method:      "DELETE",
+			Path:        fmt.Sprintf("%s/%d/%s/%d", NetworkRoutersPath, routerId, childPath, child.id),
+			QueryParams: map[string]string{},
+			Result:      &morpheus.DeleteResult{},
+		})
+		if err != nil && (resp == nil || resp.StatusCode != 404) {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return err
+		}
+		log.Printf("API RESPONSE: %s", resp)
+	}
+	return nil
+}
+
+func getNetworkRouter(client *morpheus.Client, routerId int64) (*NetworkRouter, error) {
+	resp, err := client.Execute(&morpheus.Request{
+		Method:      "GET",
+		Path:        fmt.Sprintf("%s/%d", NetworkRoutersPath, routerId),
+		QueryParams: map[string]string{},
+		Result:      &NetworkRouterResult{},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return nil, err
+	}
+	result := resp.Result.(*NetworkRouterResult)
+	if result.NetworkRouter == nil {
+		return nil, fmt.Errorf("network router %d not found in response data", routerId)
+	}
+	return result.NetworkRouter, nil
+}
+
+type NetworkRouterResult struct {
+	Success       bool              `json:"success"`
+	Message       string            `json:"msg"`
+	Errors        map[string]string `json:"errors"`
+	NetworkRouter *NetworkRouter    `json:"networkRouter"`
+}
+
+type NetworkRouter struct {
+	ID          int64                  `json:"id"`
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	Enabled     bool                   `json:"enabled"`
+	Config      map[string]interface{} `json:"config"`
+	Type        struct {
+		ID   int64  `json:"id"`
+		Code string `json:"code"`
+		Name string `json:"name"`
+	} `json:"type"`
+	Zone struct {
+		ID   int64  `json:"id"`
+		Name string `json:"name"`
+	} `json:"zone"`
+	Site struct {
+		ID   int64  `json:"id"`
+		Name string `json:"name"`
+	} `json:"site"`
+	NetworkServer struct {
+		ID   int64  `json:"id"`
+		Name string `json:"name"`
+	} `json:"networkServer"`
+	Interfaces []struct {
+		ID            int64  `json:"id"`
+		Name          string `json:"name"`
+		IPAddress     string `json:"ipAddress"`
+		InterfaceType string `json:"interfaceType"`
+		Network       struct {
+			ID   int64  `json:"id"`
+			Name string `json:"name"`
+		} `json:"network"`
+	} `json:"interfaces"`
+	Routes []struct {
+		ID          int64  `json:"id"`
+		Name        string `json:"name"`
+		Source      string `json:"source"`
+		Destination string `json:"destination"`
+		Enabled     bool   `json:"enabled"`
+	} `json:"routes"`
+	Nats []struct {
+		ID                 int64  `json:"id"`
+		Name               string `json:"name"`
+		Action             string `json:"action"`
+		SourceNetwork      string `json:"sourceNetwork"`
+		DestinationNetwork string `json:"destinationNetwork"`
+		TranslatedNetwork  string `json:"translatedNetwork"`
+		TranslatedPorts    string `json:"translatedPorts"`
+		Priority           int64  `json:"priority"`
+		Enabled            bool   `json:"enabled"`
+	} `json:"nats"`
+}
@@ -0,0 +1,428 @@
+package morpheus
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNutanixPrismCloud() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus Nutanix Prism Central cloud resource.",
+		CreateContext: resourceNutanixPrismCloudCreate,
+		ReadContext:   resourceNutanixPrismCloudRead,
+		UpdateContext: resourceNutanixPrismCloudUpdate,
+		DeleteContext: resourceNutanixPrismCloudDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(45 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(45 * time.Minute),
+			Delete: schema.DefaultTimeout(45 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of the cloud",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description: "The name of the cloud integration",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"code": {
+				Description: "Optional code for use with policies",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"location": {
+				Description: "Optional location for the cloud",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"visibility": {
+				Description:  "Determines whether the cloud is visible in sub-tenants or not",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"private", "public", ""}, false),
+				Default:      "private",
+			},
+			"tenant_id": {
+				Description: "The id of the morpheus tenant the cloud is assigned to",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"api_proxy_id": {
+				Description: "The id of the network proxy used to communicate with the cloud API",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"provisioning_proxy_id": {
+				Description: "The id of the network proxy used by instances provisioned into the cloud",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"enabled": {
+				Description: "Determines whether the cloud is active or not",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"automatically_power_on_vms": {
+				Description: "Determines whether to automatically power on cloud virtual machines",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"api_url": {
+				Description:  "The URL of the Nutanix Prism Central API (i.e. - https://prism.example.com:9440)",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"credential_id": {
+				Description:   "The ID of the credential store entry used for authentication",
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"username", "password"},
+			},
+			"username": {
+				Description: "The username of the Prism Central account used for authentication",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"password": {
+				Description: "The password of the Prism Central account used for authentication",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					h := sha256.New()
+					h.Write([]byte(new))
+					sha256_hash := hex.EncodeToString(h.Sum(nil))
+					return strings.EqualFold(old, sha256_hash)
+				},
+				RequiredWith: []string{"username"},
+			},
+			"cluster": {
+				Description: "The name of the Nutanix cluster managed by Prism Central to add, all clusters are added when set to all",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "all",
+			},
+			"import_existing_vms": {
+				Description: "Whether to import existing virtual machines",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"inventory": {
+				Type:         schema.TypeString,
+				Description:  "Whether to import existing virtual machines (off, basic, full)",
+				ValidateFunc: validation.StringInSlice([]string{"off", "basic", "full", ""}, false),
+				Optional:     true,
+				Computed:     true,
+			},
+			"appliance_url": {
+				Type:        schema.TypeString,
+				Description: "The URL used by workloads provisioned in the cloud for interacting with the Morpheus server",
+				Optional:    true,
+				Computed:    true,
+			},
+			"time_zone": {
+				Type:        schema.TypeString,
+				Description: "The time zone for the cloud",
+				Optional:    true,
+				Computed:    true,
+			},
+			"datacenter_id": {
+				Type:        schema.TypeString,
+				Description: "An arbitrary id used to reference the datacenter for the cloud",
+				Optional:    true,
+				Computed:    true,
+			},
+			"guidance": {
+				Type:         schema.TypeString,
+				Description:  "Whether to enable guidance recommendations on the cloud (manual, off)",
+				ValidateFunc: validation.StringInSlice([]string{"manual", "off"}, false),
+				Optional:     true,
+				Computed:     true,
+			},
+			"costing": {
+				Type:         schema.TypeString,
+				Description:  "Whether to enable costing on the cloud (off, costing, full)",
+				ValidateFunc: validation.StringInSlice([]string{"off", "costing", "full"}, false),
+				Optional:     true,
+				Computed:     true,
+			},
+			"agent_install_mode": {
+				Type:         schema.TypeString,
+				Description:  "The method used to install the Morpheus agent on virtual machines provisioned in the cloud (ssh, cloudInit)",
+				ValidateFunc: validation.StringInSlice([]string{"ssh", "cloudInit", ""}, false),
+				Optional:     true,
+				Computed:     true,
+			},
+			"wait_for_initial_sync": cloudWaitForInitialSyncSchema(),
+			"refresh_triggers":      cloudRefreshTriggersSchema(),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNutanixPrismCloudCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	payload := map[string]interface{}{
+		"zone": nutanixPrismCloudPayload(d),
+	}
+
+	req := &morpheus.Request{Body: payload}
+
+	resp, err := client.CreateCloud(req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	result := resp.Result.(*morpheus.CreateCloudResult)
+	cloudOutput := result.Cloud
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(cloudOutput.ID))
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"initializing", "syncing"},
+		Target:  []string{"ok"},
+		Refresh: func() (interface{}, string, error) {
+			cloudDetails, err := client.GetCloud(cloudOutput.ID, &morpheus.Request{})
+			if err != nil {
+				return "", "", err
+			}
+			result := cloudDetails.Result.(*morpheus.GetCloudResult)
+			cloudStatus := result.Cloud
+			return result, cloudStatus.Status, nil
+		},
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		MinTimeout:   1 * time.Minute,
+		Delay:        1 * time.Minute,
+		PollInterval: 1 * time.Minute,
+	}
+
+	// Wait, catching any errors
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("error creating cloud: %s", err)
+	}
+
+	if d.Get("wait_for_initial_sync").(bool) {
+		err = waitForCloudSync(ctx, client, cloudOutput.ID, "", d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.Errorf("error waiting for initial cloud sync: %s", err)
+		}
+	}
+
+	resourceNutanixPrismCloudRead(ctx, d, meta)
+	return diags
+}
+
+func resourceNutanixPrismCloudRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	name := d.Get("name").(string)
+
+	// lookup by name if we do not have an id yet
+	var resp *morpheus.Response
+	var err error
+	if id == "" && name != "" {
+		resp, err = client.FindCloudByName(name)
+	} else if id != "" {
+		resp, err = client.GetCloud(toInt64(id), &morpheus.Request{})
+	} else {
+		return diag.Errorf("Cloud cannot be read without name or id")
+	}
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	result := resp.Result.(*morpheus.GetCloudResult)
+	cloud := result.Cloud
+	if cloud == nil {
+		d.SetId("")
+		return diags
+	}
+	d.SetId(int64ToString(cloud.ID))
+	d.Set("name", cloud.Name)
+	d.Set("code", cloud.Code)
+	d.Set("location", cloud.Location)
+	d.Set("visibility", cloud.Visibility)
+	d.Set("tenant_id", strconv.Itoa(int(cloud.AccountID)))
+	d.Set("enabled", cloud.Enabled)
+	d.Set("automatically_power_on_vms", cloud.AutoRecoverPowerState)
+	d.Set("api_url", cloud.Config.APIUrl)
+	d.Set("credential_id", cloud.Credential.ID)
+	if cloud.Credential.ID == 0 {
+		d.Set("username", cloud.Config.Username)
+		d.Set("password", cloud.Config.PasswordHash)
+	}
+	if cloud.Config.Cluster == "" {
+		d.Set("cluster", "all")
+	} else {
+		d.Set("cluster", cloud.Config.Cluster)
+	}
+	d.Set("import_existing_vms", cloud.Config.ImportExisting == "on")
+	d.Set("inventory", cloud.InventoryLevel)
+	d.Set("appliance_url", cloud.Config.ApplianceUrl)
+	d.Set("time_zone", cloud.TimeZone)
+	d.Set("datacenter_id", cloud.Config.DatacenterName)
+	d.Set("guidance", cloud.GuidanceMode)
+	d.Set("costing", cloud.CostingMode)
+	d.Set("agent_install_mode", cloud.AgentMode)
+	setCloudNetworkProxies(d, resp.Body)
+	return diags
+}
+
+func resourceNutanixPrismCloudUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+	id := d.Id()
+
+	payload := map[string]interface{}{
+		"zone": nutanixPrismCloudPayload(d),
+	}
+
+	req := &morpheus.Request{Body: payload}
+	resp, err := client.UpdateCloud(toInt64(id), req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	result := resp.Result.(*morpheus.UpdateCloudResult)
+	cloudOutput := result.Cloud
+	// Successfully updated resource, now set id
+	d.SetId(int64ToString(cloudOutput.ID))
+
+	if d.HasChange("refresh_triggers") {
+		err = refreshCloud(ctx, client, cloudOutput.ID, d.Get("wait_for_initial_sync").(bool), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.Errorf("error refreshing cloud: %s", err)
+		}
+	}
+	return resourceNutanixPrismCloudRead(ctx, d, meta)
+}
+
+func resourceNutanixPrismCloudDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	req := &morpheus.Request{}
+	resp, err := client.DeleteCloud(toInt64(id), req)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return diag.FromErr(err)
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+func nutanixPrismCloudPayload(d *schema.ResourceData) map[string]interface{} {
+	cloud := make(map[string]interface{})
+	cloud["name"] = d.Get("name").(string)
+	cloud["code"] = d.Get("code").(string)
+	cloud["location"] = d.Get("location").(string)
+	cloud["visibility"] = d.Get("visibility").(string)
+
+	account := make(map[string]interface{})
+	account["id"] = d.Get("tenant_id").(string)
+	cloud["account"] = account
+	cloud["accountId"] = d.Get("tenant_id").(string)
+
+	cloud["enabled"] = d.Get("enabled").(bool)
+	cloud["autoRecoverPowerState"] = d.Get("automatically_power_on_vms").(bool)
+
+	config := make(map[string]interface{})
+	config["apiUrl"] = d.Get("api_url").(string)
+
+	if d.Get("credential_id").(int) != 0 {
+		credential := make(map[string]interface{})
+		credential["type"] = "username-password"
+		credential["id"] = d.Get("credential_id").(int)
+		cloud["credential"] = credential
+	} else {
+		credential := make(map[string]interface{})
+		credential["type"] = "local"
+		cloud["credential"] = credential
+		config["username"] = d.Get("username").(string)
+		// The state holds the hash of the password so it is only sent when changed
+		if d.HasChange("password") {
+			config["password"] = d.Get("password").(string)
+		}
+	}
+
+	if d.Get("cluster").(string) == "all" {
+		config["cluster"] = ""
+	} else {
+		config["cluster"] = d.Get("cluster").(string)
+	}
+
+	if d.Get("import_existing_vms").(bool) {
+		config["importExisting"] = "on"
+	} else {
+		config["importExisting"] = "off"
+	}
+
+	cloud["inventoryLevel"] = d.Get("inventory").(string)
+	config["applianceUrl"] = d.Get("appliance_url")
+	cloud["timezone"] = d.Get("time_zone").(string)
+	config["datacenterName"] = d.Get("datacenter_id")
+	cloud["guidanceMode"] = d.Get("guidance").(string)
+	cloud["costingMode"] = d.Get("costing").(string)
+	cloud["agentMode"] = d.Get("agent_install_mode").(string)
+
+	cloud["config"] = config
+
+	cloudType := make(map[string]interface{})
+	cloudType["code"] = "nutanixPrismPlugin"
+	cloud["zoneType"] = cloudType
+	cloudNetworkProxyPayload(d, cloud)
+	return cloud
+}
@@ -22,6 +22,7 @@ func resourcePowerShellScriptTask() *schema.Resource {
 		ReadContext:   resourcePowerShellScriptTaskRead,
 		UpdateContext: resourcePowerShellScriptTaskUpdate,
 		DeleteContext: resourcePowerShellScriptTaskDelete,
+		CustomizeDiff: validateScriptTaskSyntax("powershell"),
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -159,6 +160,12 @@ func resourcePowerShellScriptTask() *schema.Resource {
 				Optional:    true,
 				Default:     false,
 			},
+			"validate_syntax": {
+				Type:        schema.TypeBool,
+				Description: "Whether to parse the local script content with the pwsh interpreter during the plan when it is installed, syntax errors fail the plan",
+				Optional:    true,
+				Default:     false,
+			},
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
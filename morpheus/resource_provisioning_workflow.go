@@ -2,6 +2,8 @@ package morpheus
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"sort"
 
 	"log"
@@ -58,6 +60,12 @@ func resourceProvisioningWorkflow() *schema.Resource {
 				ValidateFunc: validation.StringInSlice([]string{"private", "public"}, false),
 				Default:      "private",
 			},
+			"option_type_ids": {
+				Type:        schema.TypeList,
+				Description: "The IDs of the option types associated with the provisioning workflow",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
 			"task": {
 				Type:        schema.TypeList,
 				Description: "A list of tasks associated with the provisioning workflow",
@@ -73,7 +81,24 @@ func resourceProvisioningWorkflow() *schema.Resource {
 							Type:         schema.TypeString,
 							Description:  "The phase that the task is executed (configure, price, preProvision, provision, postProvision, start, stop, preDeploy, deploy, reconfigure, teardown, shutdown, startup)",
 							Required:     true,
-							ValidateFunc: validation.StringInSlice([]string{"configure", "price", "preProvision", "provision", "postProvision", "start", "stop", "preDeploy", "deploy", "reconfigure", "teardown", "shutdown", "startup"}, false),
+							ValidateFunc: validation.StringInSlice(provisioningWorkflowPhases, false),
+						},
+						"order": {
+							Type:        schema.TypeInt,
+							Description: "The order the task is executed in within its phase, defaults to the position of the task among the listed tasks of its phase",
+							Optional:    true,
+						},
+						"options": {
+							Type:        schema.TypeMap,
+							Description: "The task options that override the options of the task when it is executed by the workflow",
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"target": {
+							Type:         schema.TypeString,
+							Description:  "The execute target that overrides the execute target of the task (local, remote, resource)",
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"local", "remote", "resource"}, false),
 						},
 					},
 				},
@@ -93,19 +118,7 @@ func resourceProvisioningWorkflowCreate(ctx context.Context, d *schema.ResourceD
 
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
-	// tasks
-	var tasks []map[string]interface{}
-	if d.Get("task") != nil {
-		taskList := d.Get("task").([]interface{})
-		// iterate over the array of tasks
-		for i := 0; i < len(taskList); i++ {
-			row := make(map[string]interface{})
-			taskconfig := taskList[i].(map[string]interface{})
-			row["taskId"] = taskconfig["task_id"]
-			row["taskPhase"] = taskconfig["task_phase"]
-			tasks = append(tasks, row)
-		}
-	}
+	tasks := provisioningWorkflowTasksPayload(d)
 
 	labelsPayload := make([]string, 0)
 	if attr, ok := d.GetOk("labels"); ok {
@@ -123,6 +136,7 @@ func resourceProvisioningWorkflowCreate(ctx context.Context, d *schema.ResourceD
 				"type":        "provision",
 				"visibility":  d.Get("visibility"),
 				"platform":    d.Get("platform"),
+				"optionTypes": d.Get("option_type_ids"),
 				"tasks":       tasks,
 			},
 		},
@@ -181,25 +195,6 @@ func resourceProvisioningWorkflowRead(ctx context.Context, d *schema.ResourceDat
 	result := resp.Result.(*morpheus.GetTaskSetResult)
 	workflow := result.TaskSet
 
-	// Tasks
-	var tasks []map[string]interface{}
-	var taskOrderList []TaskOrder
-	if len(workflow.TaskSetTasks) != 0 {
-		for _, task := range workflow.TaskSetTasks {
-			var data TaskOrder
-			data.ID = task.Task.ID
-			data.Phase = task.TaskPhase
-			data.Order = task.TaskOrder
-			taskOrderList = append(taskOrderList, data)
-		}
-		sort.Slice(taskOrderList, func(i, j int) bool { return taskOrderList[i].Order < taskOrderList[j].Order })
-		for _, task := range taskOrderList {
-			tag := make(map[string]interface{})
-			tag["task_phase"] = task.Phase
-			tag["task_id"] = task.ID
-			tasks = append(tasks, tag)
-		}
-	}
 	if workflow != nil {
 		d.SetId(int64ToString(workflow.ID))
 		d.Set("name", workflow.Name)
@@ -211,7 +206,21 @@ func resourceProvisioningWorkflowRead(ctx context.Context, d *schema.ResourceDat
 		} else {
 			d.Set("platform", workflow.Platform)
 		}
-		d.Set("task", tasks)
+		var optionTypeIds []int64
+		for _, optionType := range workflow.OptionTypes {
+			if option, ok := optionType.(map[string]interface{}); ok {
+				optionTypeIds = append(optionTypeIds, int64(option["id"].(float64)))
+			}
+		}
+		d.Set("option_type_ids", optionTypeIds)
+
+		// The sdk does not include the task overrides so the
+		// workflow tasks are read from the raw response
+		var workflowPayload ProvisioningWorkflowPayload
+		if err := json.Unmarshal(resp.Body, &workflowPayload); err != nil {
+			return diag.FromErr(err)
+		}
+		d.Set("task", flattenProvisioningWorkflowTasks(d, workflowPayload.TaskSet.TaskSetTasks))
 	} else {
 		return diag.Errorf("read operation: workflow not found in response data") // should not happen
 	}
@@ -224,19 +233,7 @@ func resourceProvisioningWorkflowUpdate(ctx context.Context, d *schema.ResourceD
 	id := d.Id()
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
-	// tasks
-	var tasks []map[string]interface{}
-	if d.Get("task") != nil {
-		taskList := d.Get("task").([]interface{})
-		// iterate over the array of tasks
-		for i := 0; i < len(taskList); i++ {
-			row := make(map[string]interface{})
-			taskconfig := taskList[i].(map[string]interface{})
-			row["taskId"] = taskconfig["task_id"]
-			row["taskPhase"] = taskconfig["task_phase"]
-			tasks = append(tasks, row)
-		}
-	}
+	tasks := provisioningWorkflowTasksPayload(d)
 
 	labelsPayload := make([]string, 0)
 	if attr, ok := d.GetOk("labels"); ok {
@@ -253,6 +250,7 @@ func resourceProvisioningWorkflowUpdate(ctx context.Context, d *schema.ResourceD
 				"labels":      labelsPayload,
 				"visibility":  d.Get("visibility"),
 				"platform":    d.Get("platform"),
+				"optionTypes": d.Get("option_type_ids"),
 				"tasks":       tasks,
 			},
 		},
@@ -294,8 +292,117 @@ func resourceProvisioningWorkflowDelete(ctx context.Context, d *schema.ResourceD
 	return diags
 }
 
-type TaskOrder struct {
-	Order int64  `json:"order"`
-	ID    int64  `json:"id"`
-	Phase string `json:"phase"`
+var provisioningWorkflowPhases = []string{"configure", "price", "preProvision", "provision", "postProvision", "start", "stop", "preDeploy", "deploy", "reconfigure", "teardown", "shutdown", "startup"}
+
+// provisioningWorkflowTasksPayload sends the order of each task within its
+// phase, tasks without an order are ordered by their position among the
+// listed tasks of the phase
+func provisioningWorkflowTasksPayload(d *schema.ResourceData) []map[string]interface{} {
+	var tasks []map[string]interface{}
+	phaseOrder := make(map[string]int)
+	for _, v := range d.Get("task").([]interface{}) {
+		taskconfig := v.(map[string]interface{})
+		phase := taskconfig["task_phase"].(string)
+		phaseOrder[phase]++
+
+		row := make(map[string]interface{})
+		row["taskId"] = taskconfig["task_id"]
+		row["taskPhase"] = phase
+		row["taskOrder"] = phaseOrder[phase]
+		if order := taskconfig["order"].(int); order > 0 {
+			row["taskOrder"] = order
+		}
+		taskOptions := make(map[string]interface{})
+		for k, v := range taskconfig["options"].(map[string]interface{}) {
+			taskOptions[k] = v.(string)
+		}
+		row["taskOptions"] = taskOptions
+		if target := taskconfig["target"].(string); target != "" {
+			row["executeTarget"] = target
+		}
+		tasks = append(tasks, row)
+	}
+	return tasks
+}
+
+// flattenProvisioningWorkflowTasks returns the workflow tasks in the order of
+// the configured task blocks so the plan is not affected by the order the
+// appliance returns them in, the configured order is read from the
+// appliance so a task that is reordered outside of terraform shows in the
+// plan. Tasks that are not configured are appended in the order of their phase
+func flattenProvisioningWorkflowTasks(d *schema.ResourceData, workflowTasks []ProvisioningWorkflowTask) []map[string]interface{} {
+	phaseIndex := make(map[string]int)
+	for i, phase := range provisioningWorkflowPhases {
+		phaseIndex[phase] = i
+	}
+	remaining := append([]ProvisioningWorkflowTask{}, workflowTasks...)
+	sort.SliceStable(remaining, func(i, j int) bool {
+		if remaining[i].TaskPhase != remaining[j].TaskPhase {
+			return phaseIndex[remaining[i].TaskPhase] < phaseIndex[remaining[j].TaskPhase]
+		}
+		return remaining[i].TaskOrder < remaining[j].TaskOrder
+	})
+
+	var tasks []map[string]interface{}
+	for _, v := range d.Get("task").([]interface{}) {
+		taskconfig := v.(map[string]interface{})
+		for i, workflowTask := range remaining {
+			if int(workflowTask.Task.ID) != taskconfig["task_id"].(int) || workflowTask.TaskPhase != taskconfig["task_phase"].(string) {
+				continue
+			}
+			// Only the configured options are tracked since the
+			// appliance returns the options of the task as well
+			options := make(map[string]interface{})
+			for k, option := range taskconfig["options"].(map[string]interface{}) {
+				if value, ok := workflowTask.TaskOptions[k]; ok && value != nil {
+					options[k] = fmt.Sprintf("%v", value)
+				} else {
+					options[k] = option
+				}
+			}
+			task := flattenProvisioningWorkflowTask(workflowTask, options)
+			// The order defaults to the position of the task so it is only
+			// tracked when it is configured
+			if taskconfig["order"].(int) == 0 {
+				task["order"] = 0
+			}
+			// The target is only tracked when it overrides the target of the task
+			if taskconfig["target"].(string) == "" || workflowTask.ExecuteTarget == "" {
+				task["target"] = taskconfig["target"].(string)
+			}
+			tasks = append(tasks, task)
+			remaining = append(remaining[:i], remaining[i+1:]...)
+			break
+		}
+	}
+	for _, workflowTask := range remaining {
+		tasks = append(tasks, flattenProvisioningWorkflowTask(workflowTask, map[string]interface{}{}))
+	}
+	return tasks
+}
+
+func flattenProvisioningWorkflowTask(workflowTask ProvisioningWorkflowTask, options map[string]interface{}) map[string]interface{} {
+	task := make(map[string]interface{})
+	task["task_id"] = workflowTask.Task.ID
+	task["task_phase"] = workflowTask.TaskPhase
+	task["order"] = workflowTask.TaskOrder
+	task["options"] = options
+	task["target"] = workflowTask.ExecuteTarget
+	return task
+}
+
+type ProvisioningWorkflowTask struct {
+	TaskPhase     string                 `json:"taskPhase"`
+	TaskOrder     int64                  `json:"taskOrder"`
+	TaskOptions   map[string]interface{} `json:"taskOptions"`
+	ExecuteTarget string                 `json:"executeTarget"`
+	Task          struct {
+		ID int64 `json:"id"`
+	} `json:"task"`
+}
+
+type ProvisioningWorkflowPayload struct {
+	TaskSet struct {
+		TaskSetTasks []ProvisioningWorkflowTask `json:"taskSetTasks"`
+	} `json:"taskSet"`
 }
@@ -19,6 +19,7 @@ func resourcePythonScriptTask() *schema.Resource {
 		ReadContext:   resourcePythonScriptTaskRead,
 		UpdateContext: resourcePythonScriptTaskUpdate,
 		DeleteContext: resourcePythonScriptTaskDelete,
+		CustomizeDiff: validateScriptTaskSyntax("python"),
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -131,6 +132,12 @@ func resourcePythonScriptTask() *schema.Resource {
 				Optional:    true,
 				Default:     false,
 			},
+			"validate_syntax": {
+				Type:        schema.TypeBool,
+				Description: "Whether to parse the local script content with the python3 interpreter during the plan when it is installed, syntax errors fail the plan",
+				Optional:    true,
+				Default:     false,
+			},
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -0,0 +1,349 @@
+package morpheus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceResourcePool() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus resource pool resource, such as an AWS VPC, Azure resource group, vSphere resource pool or OpenStack project",
+		CreateContext: resourceResourcePoolCreate,
+		ReadContext:   resourceResourcePoolRead,
+		UpdateContext: resourceResourcePoolUpdate,
+		DeleteContext: resourceResourcePoolDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the resource pool",
+				Computed:    true,
+			},
+			"cloud_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the cloud to create the resource pool in",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the resource pool",
+				Required:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "The description of the resource pool",
+				Optional:    true,
+				Computed:    true,
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Description: "Whether the resource pool is active and available for provisioning",
+				Optional:    true,
+				Default:     true,
+			},
+			"default_pool": {
+				Type:        schema.TypeBool,
+				Description: "Whether the resource pool is selected by default when provisioning into the cloud",
+				Optional:    true,
+				Default:     false,
+			},
+			"visibility": {
+				Type:         schema.TypeString,
+				Description:  "Whether the resource pool is visible in sub-tenants or not (private, public)",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"private", "public"}, false),
+				Default:      "private",
+			},
+			"parent_pool_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the parent resource pool, used to nest vSphere resource pools",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"region_code": {
+				Type:        schema.TypeString,
+				Description: "The region the resource pool is created in, such as the region of an AWS VPC or Azure resource group",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"cidr_block": {
+				Type:         schema.TypeString,
+				Description:  "The CIDR block of the AWS VPC",
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsCIDR,
+			},
+			"tenancy": {
+				Type:         schema.TypeString,
+				Description:  "The instance tenancy of the AWS VPC (default, dedicated)",
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"default", "dedicated"}, false),
+			},
+			"config": {
+				Type:        schema.TypeMap,
+				Description: "Additional provider specific configuration of the resource pool",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"external_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the resource pool in the cloud provider",
+				Computed:    true,
+			},
+			"group_access_all": {
+				Type:        schema.TypeBool,
+				Description: "Whether to grant all groups access to the resource pool",
+				Optional:    true,
+				Default:     false,
+			},
+			"group_access_ids": {
+				Type:        schema.TypeSet,
+				Description: "A list of group ids to grant access to the resource pool",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"group_default_ids": {
+				Type:        schema.TypeSet,
+				Description: "A list of group ids that use the resource pool as their default, the groups must also be granted access",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"plan_access_all": {
+				Type:        schema.TypeBool,
+				Description: "Whether to grant all service plans access to the resource pool",
+				Optional:    true,
+				Default:     true,
+			},
+			"plan_access_ids": {
+				Type:        schema.TypeSet,
+				Description: "A list of service plan ids to grant access to the resource pool",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"tenant_ids": {
+				Type:        schema.TypeSet,
+				Description: "A list of tenant ids to grant access to the resource pool",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceResourcePoolImport,
+		},
+	}
+}
+
+func resourceResourcePoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	cloudId := d.Get("cloud_id").(int)
+
+	req := &morpheus.Request{
+		Body: resourcePoolPayload(d),
+	}
+	resp, err := client.CreateResourcePool(int64(cloudId), req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.CreateResourcePoolResult)
+	if result.ResourcePool == nil {
+		return diag.Errorf("Resource pool not found in response data.") // should not happen
+	}
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(result.ResourcePool.ID))
+
+	resourceResourcePoolRead(ctx, d, meta)
+	return diags
+}
+
+func resourceResourcePoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	cloudId := d.Get("cloud_id").(int)
+
+	resp, err := client.GetResourcePool(int64(cloudId), toInt64(id), &morpheus.Request{})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	// The sdk resource pool does not include the parent pool and the
+	// resource permissions so the raw response is parsed
+	var result CloudResourcePoolResult
+	if err := json.Unmarshal(resp.Body, &result); err != nil {
+		return diag.FromErr(err)
+	}
+	resourcePool := result.ResourcePool
+	d.SetId(int64ToString(resourcePool.ID))
+	d.Set("cloud_id", resourcePool.Zone.ID)
+	d.Set("name", resourcePool.Name)
+	d.Set("description", resourcePool.Description)
+	d.Set("active", resourcePool.Active)
+	d.Set("default_pool", resourcePool.DefaultPool)
+	d.Set("visibility", resourcePool.Visibility)
+	if resourcePool.Parent != nil {
+		d.Set("parent_pool_id", resourcePool.Parent.ID)
+	}
+	d.Set("region_code", resourcePool.RegionCode)
+	if _, ok := d.GetOk("cidr_block"); ok {
+		d.Set("cidr_block", resourcePool.Config.CidrBlock)
+	}
+	if _, ok := d.GetOk("tenancy"); ok {
+		d.Set("tenancy", resourcePool.Config.Tenancy)
+	}
+	d.Set("external_id", resourcePool.ExternalId)
+	setCloudResourcePermissions(d, resourcePool.ResourcePermission, resourcePool.Tenants)
+
+	return diags
+}
+
+func resourceResourcePoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	id := d.Id()
+	cloudId := d.Get("cloud_id").(int)
+
+	req := &morpheus.Request{
+		Body: resourcePoolPayload(d),
+	}
+	resp, err := client.UpdateResourcePool(int64(cloudId), toInt64(id), req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	return resourceResourcePoolRead(ctx, d, meta)
+}
+
+func resourceResourcePoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	cloudId := d.Get("cloud_id").(int)
+
+	resp, err := client.DeleteResourcePool(int64(cloudId), toInt64(id), &morpheus.Request{})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+// resourceResourcePoolImport accepts an import id in the format of
+// <cloud_id>:<resource_pool_id> since resource pools are nested under the cloud
+func resourceResourcePoolImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected format of id (%s), expected cloud_id:resource_pool_id", d.Id())
+	}
+	d.Set("cloud_id", int(stringToInt64(parts[0])))
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourcePoolPayload(d *schema.ResourceData) map[string]interface{} {
+	config := make(map[string]interface{})
+	for k, v := range d.Get("config").(map[string]interface{}) {
+		config[k] = v.(string)
+	}
+	if v, ok := d.GetOk("cidr_block"); ok {
+		config["cidrBlock"] = v.(string)
+	}
+	if v, ok := d.GetOk("tenancy"); ok {
+		config["tenancy"] = v.(string)
+	}
+
+	resourcePool := map[string]interface{}{
+		"name":                d.Get("name").(string),
+		"description":         d.Get("description").(string),
+		"active":              d.Get("active").(bool),
+		"defaultPool":         d.Get("default_pool").(bool),
+		"visibility":          d.Get("visibility").(string),
+		"config":              config,
+		"resourcePermissions": cloudResourcePermissionsPayload(d),
+		"tenants":             cloudResourceTenantsPayload(d),
+	}
+
+	if v, ok := d.GetOk("parent_pool_id"); ok {
+		resourcePool["parentPool"] = map[string]interface{}{
+			"id": v.(int),
+		}
+	}
+	if v, ok := d.GetOk("region_code"); ok {
+		resourcePool["regionCode"] = v.(string)
+	}
+
+	return map[string]interface{}{
+		"resourcePool": resourcePool,
+	}
+}
+
+type CloudResourcePoolResult struct {
+	Success      bool              `json:"success"`
+	Message      string            `json:"msg"`
+	Errors       map[string]string `json:"errors"`
+	ResourcePool struct {
+		ID          int64  `json:"id"`
+		Name        string `json:"name"`
+		Description string `json:"description"`
+		Zone        struct {
+			ID int64 `json:"id"`
+		} `json:"zone"`
+		Parent *struct {
+			ID int64 `json:"id"`
+		} `json:"parent"`
+		Active      bool   `json:"active"`
+		DefaultPool bool   `json:"defaultPool"`
+		Visibility  string `json:"visibility"`
+		ExternalId  string `json:"externalId"`
+		RegionCode  string `json:"regionCode"`
+		Config      struct {
+			CidrBlock string `json:"cidrBlock"`
+			Tenancy   string `json:"tenancy"`
+		} `json:"config"`
+		Tenants            []CloudResourceTenant    `json:"tenants"`
+		ResourcePermission CloudResourcePermissions `json:"resourcePermission"`
+	} `json:"resourcePool"`
+}
@@ -18,6 +18,7 @@ func resourceRestOptionList() *schema.Resource {
 		ReadContext:   resourceRestOptionListRead,
 		UpdateContext: resourceRestOptionListUpdate,
 		DeleteContext: resourceRestOptionListDelete,
+		CustomizeDiff: setOptionListTestOutput,
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -110,8 +111,9 @@ func resourceRestOptionList() *schema.Resource {
 			},
 			"translation_script": {
 				Type:             schema.TypeString,
-				Description:      "A js script to translate the result data object into an Array containing objects with properties 'name’ and 'value’.",
+				Description:      "A js script to translate the result data object into an Array containing objects with properties 'name’ and 'value’. The script is parsed during the plan to report syntax errors",
 				DiffSuppressFunc: supressOptionListScripts,
+				ValidateDiagFunc: validateOptionListScript,
 				Optional:         true,
 				Computed:         true,
 			},
@@ -119,9 +121,35 @@ func resourceRestOptionList() *schema.Resource {
 				Type:             schema.TypeString,
 				Description:      "A js script to prepare the API request",
 				DiffSuppressFunc: supressOptionListScripts,
+				ValidateDiagFunc: validateOptionListScript,
 				Optional:         true,
 				Computed:         true,
 			},
+			"test_input": {
+				Type:         schema.TypeString,
+				Description:  "A sample json payload that is run through the translation script during the plan, the resulting options are exposed as the test_output",
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"test_output": {
+				Type:        schema.TypeList,
+				Description: "The options returned by the translation script for the test_input",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The name of the option",
+							Computed:    true,
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Description: "The value of the option",
+							Computed:    true,
+						},
+					},
+				},
+			},
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -0,0 +1,234 @@
+package morpheus
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceRoute53Integration() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides an AWS Route 53 DNS integration resource",
+		CreateContext: resourceRoute53IntegrationCreate,
+		ReadContext:   resourceRoute53IntegrationRead,
+		UpdateContext: resourceRoute53IntegrationUpdate,
+		DeleteContext: resourceRoute53IntegrationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the Route 53 integration",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the Route 53 integration",
+				Required:    true,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether the Route 53 integration is enabled",
+				Optional:    true,
+				Default:     true,
+			},
+			"credential_id": {
+				Type:          schema.TypeInt,
+				Description:   "The ID of the credential store entry used for authentication",
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"access_key", "secret_key"},
+			},
+			"access_key": {
+				Type:        schema.TypeString,
+				Description: "The AWS access key used for authentication",
+				Optional:    true,
+				Computed:    true,
+			},
+			"secret_key": {
+				Type:        schema.TypeString,
+				Description: "The AWS secret key used for authentication",
+				Optional:    true,
+				Sensitive:   true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					h := sha256.New()
+					h.Write([]byte(new))
+					sha256_hash := hex.EncodeToString(h.Sum(nil))
+					return strings.EqualFold(old, sha256_hash)
+				},
+				DiffSuppressOnRefresh: true,
+				RequiredWith:          []string{"access_key"},
+			},
+			"role_arn": {
+				Type:        schema.TypeString,
+				Description: "The AWS IAM role ARN to assume for authentication",
+				Optional:    true,
+				Computed:    true,
+			},
+			"use_host_iam_credentials": {
+				Description:   "Whether to use the IAM profile associated with the Morpheus server or not",
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"access_key", "secret_key"},
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceRoute53IntegrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"integration": route53IntegrationPayload(d),
+		},
+	}
+
+	resp, err := client.CreateIntegration(req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.CreateIntegrationResult)
+	integrationResult := result.Integration
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(integrationResult.ID))
+
+	resourceRoute53IntegrationRead(ctx, d, meta)
+	return diags
+}
+
+func resourceRoute53IntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	integration, resp, err := getNetworkIntegration(client, d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	d.SetId(int64ToString(integration.ID))
+	d.Set("name", integration.Name)
+	d.Set("enabled", integration.Enabled)
+	d.Set("credential_id", integration.Credential.ID)
+	if accessKey, ok := integration.Config["accessKey"].(string); ok {
+		d.Set("access_key", accessKey)
+	}
+	if secretKeyHash, ok := integration.Config["secretKeyHash"].(string); ok {
+		d.Set("secret_key", secretKeyHash)
+	}
+	if roleArn, ok := integration.Config["stsAssumeRole"].(string); ok {
+		d.Set("role_arn", roleArn)
+	}
+	d.Set("use_host_iam_credentials", networkIntegrationConfigBool(integration.Config["useHostCredentials"]))
+	return diags
+}
+
+func resourceRoute53IntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+	id := d.Id()
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"integration": route53IntegrationPayload(d),
+		},
+	}
+
+	resp, err := client.UpdateIntegration(toInt64(id), req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	result := resp.Result.(*morpheus.UpdateIntegrationResult)
+	integrationResult := result.Integration
+
+	// Successfully updated resource, now set id
+	// err, it should not have changed though..
+	d.SetId(int64ToString(integrationResult.ID))
+	return resourceRoute53IntegrationRead(ctx, d, meta)
+}
+
+func resourceRoute53IntegrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	req := &morpheus.Request{}
+	resp, err := client.DeleteIntegration(toInt64(id), req)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return diag.FromErr(err)
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+func route53IntegrationPayload(d *schema.ResourceData) map[string]interface{} {
+	integration := make(map[string]interface{})
+	integration["name"] = d.Get("name").(string)
+	integration["enabled"] = d.Get("enabled").(bool)
+	integration["type"] = "amazonDns"
+
+	config := make(map[string]interface{})
+	if d.Get("credential_id").(int) != 0 {
+		credential := make(map[string]interface{})
+		credential["type"] = "access-key-secret"
+		credential["id"] = d.Get("credential_id").(int)
+		integration["credential"] = credential
+	} else {
+		credential := make(map[string]interface{})
+		credential["type"] = "local"
+		integration["credential"] = credential
+		config["accessKey"] = d.Get("access_key").(string)
+		// The state holds the hash of the secret key so it is only sent when changed
+		if d.HasChange("secret_key") {
+			config["secretKey"] = d.Get("secret_key").(string)
+		}
+	}
+
+	if d.Get("use_host_iam_credentials").(bool) {
+		config["useHostCredentials"] = "on"
+	} else {
+		config["useHostCredentials"] = "off"
+	}
+	config["stsAssumeRole"] = d.Get("role_arn").(string)
+	integration["config"] = config
+
+	return integration
+}
@@ -19,6 +19,7 @@ func resourceRubyScriptTask() *schema.Resource {
 		ReadContext:   resourceRubyScriptTaskRead,
 		UpdateContext: resourceRubyScriptTaskUpdate,
 		DeleteContext: resourceRubyScriptTaskDelete,
+		CustomizeDiff: validateScriptTaskSyntax("ruby"),
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -107,6 +108,12 @@ func resourceRubyScriptTask() *schema.Resource {
 				Optional:    true,
 				Default:     false,
 			},
+			"validate_syntax": {
+				Type:        schema.TypeBool,
+				Description: "Whether to parse the local script content with the ruby interpreter during the plan when it is installed, syntax errors fail the plan",
+				Optional:    true,
+				Default:     false,
+			},
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -21,6 +21,7 @@ func resourceShellScriptTask() *schema.Resource {
 		ReadContext:   resourceShellScriptTaskRead,
 		UpdateContext: resourceShellScriptTaskUpdate,
 		DeleteContext: resourceShellScriptTaskDelete,
+		CustomizeDiff: validateScriptTaskSyntax("shell"),
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -178,6 +179,12 @@ func resourceShellScriptTask() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 			},
+			"validate_syntax": {
+				Type:        schema.TypeBool,
+				Description: "Whether to parse the local script content with the bash interpreter during the plan when it is installed, syntax errors fail the plan",
+				Optional:    true,
+				Default:     false,
+			},
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -3,6 +3,7 @@ package morpheus
 import (
 	"context"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/gomorpheus/morpheus-go-sdk"
@@ -19,7 +20,13 @@ func resourceStandardCloud() *schema.Resource {
 		ReadContext:   resourceStandardCloudRead,
 		UpdateContext: resourceStandardCloudUpdate,
 		DeleteContext: resourceStandardCloudDelete,
-
+		CustomizeDiff: setLogoImageChecksums(logoAttributes, darkLogoAttributes),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(45 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(45 * time.Minute),
+			Delete: schema.DefaultTimeout(45 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Description: "The ID of the cloud",
@@ -114,7 +121,16 @@ func resourceStandardCloud() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 			},
-			/* Awaiting SDK Support
+			"api_proxy_id": {
+				Description: "The id of the network proxy used to communicate with the cloud API",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"provisioning_proxy_id": {
+				Description: "The id of the network proxy used by instances provisioned into the cloud",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
 			"logo_image_name": {
 				Type:        schema.TypeString,
 				Description: "The file name of the cloud logo image",
@@ -139,7 +155,32 @@ func resourceStandardCloud() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 			},
-			*/
+			"logo_content_base64": {
+				Type:          schema.TypeString,
+				Description:   "The base64 encoded content of the cloud logo image, used instead of the logo_image_path so the image does not need to exist on the machine running terraform",
+				Optional:      true,
+				ConflictsWith: []string{"logo_image_path"},
+				RequiredWith:  []string{"logo_image_name"},
+			},
+			"logo_checksum": {
+				Type:        schema.TypeString,
+				Description: "The SHA256 checksum of the cloud logo image, the logo is uploaded again when the content of the image changes",
+				Computed:    true,
+			},
+			"dark_logo_content_base64": {
+				Type:          schema.TypeString,
+				Description:   "The base64 encoded content of the cloud dark mode logo image, used instead of the dark_logo_image_path so the image does not need to exist on the machine running terraform",
+				Optional:      true,
+				ConflictsWith: []string{"dark_logo_image_path"},
+				RequiredWith:  []string{"dark_logo_image_name"},
+			},
+			"dark_logo_checksum": {
+				Type:        schema.TypeString,
+				Description: "The SHA256 checksum of the cloud dark mode logo image, the logo is uploaded again when the content of the image changes",
+				Computed:    true,
+			},
+			"wait_for_initial_sync": cloudWaitForInitialSyncSchema(),
+			"refresh_triggers":      cloudRefreshTriggersSchema(),
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -217,6 +258,7 @@ func resourceStandardCloudCreate(ctx context.Context, d *schema.ResourceData, me
 	cloud["zoneType"] = cloudType
 
 	cloud["config"] = config
+	cloudNetworkProxyPayload(d, cloud)
 
 	payload := map[string]interface{}{
 		"zone": cloud,
@@ -231,6 +273,8 @@ func resourceStandardCloudCreate(ctx context.Context, d *schema.ResourceData, me
 	log.Printf("API RESPONSE: %s", resp)
 	result := resp.Result.(*morpheus.CreateCloudResult)
 	cloudOutput := result.Cloud
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(cloudOutput.ID))
 
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"initializing", "syncing"},
@@ -244,7 +288,7 @@ func resourceStandardCloudCreate(ctx context.Context, d *schema.ResourceData, me
 			cloudStatus := result.Cloud
 			return result, cloudStatus.Status, nil
 		},
-		Timeout:      1 * time.Hour,
+		Timeout:      d.Timeout(schema.TimeoutCreate),
 		MinTimeout:   1 * time.Minute,
 		PollInterval: 30 * time.Second,
 	}
@@ -255,44 +299,25 @@ func resourceStandardCloudCreate(ctx context.Context, d *schema.ResourceData, me
 		return diag.Errorf("error creating cloud: %s", err)
 	}
 
-	/* Awaiting SDK support
-	var filePayloads []*morpheus.FilePayload
-
-	if d.Get("logo_image_path") != "" && d.Get("logo_image_name") != "" {
-		data, err := os.ReadFile(d.Get("logo_image_path").(string))
+	if d.Get("wait_for_initial_sync").(bool) {
+		err = waitForCloudSync(ctx, client, cloudOutput.ID, "", d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return diag.FromErr(err)
+			return diag.Errorf("error waiting for initial cloud sync: %s", err)
 		}
+	}
 
-		filePayload := &morpheus.FilePayload{
-			ParameterName: "logo",
-			FileName:      d.Get("logo_image_name").(string),
-			FileContent:   data,
-		}
-		filePayloads = append(filePayloads, filePayload)
+	filePayloads, err := logoImagePayloads(d, logoAttributes, darkLogoAttributes)
+	if err != nil {
+		return diag.FromErr(err)
 	}
-	if d.Get("dark_logo_image_path") != "" && d.Get("dark_logo_image_name") != "" {
-		darkLogoData, err := os.ReadFile(d.Get("dark_logo_image_path").(string))
+	if len(filePayloads) > 0 {
+		response, err := client.UpdateCloudLogo(cloudOutput.ID, filePayloads, &morpheus.Request{})
 		if err != nil {
+			log.Printf("API FAILURE: %s - %s", response, err)
 			return diag.FromErr(err)
 		}
-
-		darkLogoPayload := &morpheus.FilePayload{
-			ParameterName: "darkLogo",
-			FileName:      d.Get("dark_logo_image_name").(string),
-			FileContent:   darkLogoData,
-		}
-		filePayloads = append(filePayloads, darkLogoPayload)
-	}
-
-	response, err := client.UpdateCloudLogo(cloudOutput.ID, filePayloads, &morpheus.Request{})
-	if err != nil {
-		log.Printf("API FAILURE: %s - %s", response, err)
+		log.Printf("API RESPONSE: %s", response)
 	}
-	log.Printf("API RESPONSE: %s", response)
-	*/
-	// Successfully created resource, now set id
-	d.SetId(int64ToString(cloudOutput.ID))
 	resourceStandardCloudRead(ctx, d, meta)
 	return diags
 }
@@ -359,14 +384,13 @@ func resourceStandardCloudRead(ctx context.Context, d *schema.ResourceData, meta
 		d.Set("guidance", cloud.GuidanceMode)
 		d.Set("costing", cloud.CostingMode)
 		d.Set("agent_install_mode", cloud.AgentMode)
-		/* Awaiting SDK Support
+		setCloudNetworkProxies(d, resp.Body)
 		imagePath := strings.Split(cloud.ImagePath, "/")
 		opt := strings.Replace(imagePath[len(imagePath)-1], "_original", "", 1)
 		d.Set("logo_image_name", opt)
-		darkImagePath := strings.Split(catalogItem.DarkImagePath, "/")
+		darkImagePath := strings.Split(cloud.DarkImagePath, "/")
 		darkOpt := strings.Replace(darkImagePath[len(darkImagePath)-1], "_original", "", 1)
 		d.Set("dark_logo_image_name", darkOpt)
-		*/
 		return diags
 	}
 }
@@ -435,6 +459,7 @@ func resourceStandardCloudUpdate(ctx context.Context, d *schema.ResourceData, me
 	cloud["zoneType"] = cloudType
 
 	cloud["config"] = config
+	cloudNetworkProxyPayload(d, cloud)
 
 	payload := map[string]interface{}{
 		"zone": cloud,
@@ -450,45 +475,28 @@ func resourceStandardCloudUpdate(ctx context.Context, d *schema.ResourceData, me
 	result := resp.Result.(*morpheus.UpdateCloudResult)
 	cloudOutput := result.Cloud
 
-	/* Awaiting SDK Support
-	var filePayloads []*morpheus.FilePayload
-
-	if d.HasChange("logo_image_path") || d.HasChange("logo_image_name") {
-		data, err := os.ReadFile(d.Get("logo_image_path").(string))
-		if err != nil {
-			return diag.FromErr(err)
-		}
-
-		filePayload := &morpheus.FilePayload{
-			ParameterName: "logo",
-			FileName:      d.Get("logo_image_name").(string),
-			FileContent:   data,
-		}
-		filePayloads = append(filePayloads, filePayload)
+	filePayloads, err := logoImagePayloads(d, logoAttributes, darkLogoAttributes)
+	if err != nil {
+		return diag.FromErr(err)
 	}
-	if d.HasChange("dark_logo_image_path") || d.HasChange("dark_logo_image_name") {
-		darkLogoData, err := os.ReadFile(d.Get("dark_logo_image_path").(string))
+	if len(filePayloads) > 0 {
+		response, err := client.UpdateCloudLogo(cloudOutput.ID, filePayloads, &morpheus.Request{})
 		if err != nil {
+			log.Printf("API FAILURE: %s - %s", response, err)
 			return diag.FromErr(err)
 		}
-
-		darkLogoPayload := &morpheus.FilePayload{
-			ParameterName: "darkLogo",
-			FileName:      d.Get("dark_logo_image_name").(string),
-			FileContent:   darkLogoData,
-		}
-		filePayloads = append(filePayloads, darkLogoPayload)
-	}
-
-	response, err := client.UpdateCatalogItemLogo(catalogItemResult.ID, filePayloads, &morpheus.Request{})
-	if err != nil {
-		log.Printf("API FAILURE: %s - %s", response, err)
+		log.Printf("API RESPONSE: %s", response)
 	}
-	log.Printf("API RESPONSE: %s", response)
-	*/
 
 	// Successfully updated resource, now set id
 	d.SetId(int64ToString(cloudOutput.ID))
+
+	if d.HasChange("refresh_triggers") {
+		err = refreshCloud(ctx, client, cloudOutput.ID, d.Get("wait_for_initial_sync").(bool), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.Errorf("error refreshing cloud: %s", err)
+		}
+	}
 	return resourceStandardCloudRead(ctx, d, meta)
 }
 
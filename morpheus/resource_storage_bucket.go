@@ -0,0 +1,386 @@
+package morpheus
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceStorageBucket() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus storage bucket resource",
+		CreateContext: resourceStorageBucketCreate,
+		ReadContext:   resourceStorageBucketRead,
+		UpdateContext: resourceStorageBucketUpdate,
+		DeleteContext: resourceStorageBucketDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the storage bucket",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the storage bucket",
+				Required:    true,
+			},
+			"provider_type": {
+				Type:         schema.TypeString,
+				Description:  "The storage provider type of the storage bucket (s3, azure, google, local)",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"s3", "azure", "google", "local"}, false),
+			},
+			"bucket_name": {
+				Type:        schema.TypeString,
+				Description: "The name of the bucket or container in the storage provider, not used by the local provider type",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"create_bucket": {
+				Type:        schema.TypeBool,
+				Description: "Whether to create the bucket in the storage provider if it does not exist",
+				Optional:    true,
+				Default:     false,
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Description: "Whether the storage bucket is active",
+				Optional:    true,
+				Default:     true,
+			},
+			"credential_id": {
+				Type:          schema.TypeInt,
+				Description:   "The ID of the credential store entry used to authenticate with the storage provider",
+				Optional:      true,
+				ConflictsWith: []string{"access_key", "secret_key", "storage_account", "storage_key", "client_email", "private_key"},
+			},
+			"access_key": {
+				Type:          schema.TypeString,
+				Description:   "The access key used to authenticate with the s3 storage provider",
+				Optional:      true,
+				ConflictsWith: []string{"credential_id"},
+			},
+			"secret_key": {
+				Type:          schema.TypeString,
+				Description:   "The secret key used to authenticate with the s3 storage provider",
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"credential_id"},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					h := sha256.New()
+					h.Write([]byte(new))
+					sha256_hash := hex.EncodeToString(h.Sum(nil))
+					return strings.EqualFold(old, sha256_hash)
+				},
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Description: "The region of the s3 bucket",
+				Optional:    true,
+			},
+			"endpoint": {
+				Type:        schema.TypeString,
+				Description: "The endpoint url of an s3 compatible storage provider",
+				Optional:    true,
+			},
+			"storage_account": {
+				Type:          schema.TypeString,
+				Description:   "The name of the azure storage account",
+				Optional:      true,
+				ConflictsWith: []string{"credential_id"},
+			},
+			"storage_key": {
+				Type:          schema.TypeString,
+				Description:   "The access key of the azure storage account",
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"credential_id"},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					h := sha256.New()
+					h.Write([]byte(new))
+					sha256_hash := hex.EncodeToString(h.Sum(nil))
+					return strings.EqualFold(old, sha256_hash)
+				},
+			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the google cloud project",
+				Optional:    true,
+			},
+			"client_email": {
+				Type:          schema.TypeString,
+				Description:   "The client email of the google cloud service account",
+				Optional:      true,
+				ConflictsWith: []string{"credential_id"},
+			},
+			"private_key": {
+				Type:          schema.TypeString,
+				Description:   "The private key of the google cloud service account",
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"credential_id"},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					h := sha256.New()
+					h.Write([]byte(new))
+					sha256_hash := hex.EncodeToString(h.Sum(nil))
+					return strings.EqualFold(old, sha256_hash)
+				},
+			},
+			"storage_class": {
+				Type:        schema.TypeString,
+				Description: "The storage class of the google cloud storage bucket",
+				Optional:    true,
+			},
+			"base_path": {
+				Type:        schema.TypeString,
+				Description: "The path on the appliance used by the local provider type",
+				Optional:    true,
+			},
+			"default_backup_target": {
+				Type:        schema.TypeBool,
+				Description: "Whether the storage bucket is the default target for backups",
+				Optional:    true,
+				Default:     false,
+			},
+			"default_deployment_target": {
+				Type:        schema.TypeBool,
+				Description: "Whether the storage bucket is the default target for deployment archives",
+				Optional:    true,
+				Default:     false,
+			},
+			"default_virtual_image_target": {
+				Type:        schema.TypeBool,
+				Description: "Whether the storage bucket is the default target for virtual image uploads",
+				Optional:    true,
+				Default:     false,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceStorageBucketCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"storageBucket": storageBucketPayload(d),
+		},
+	}
+
+	resp, err := client.CreateStorageBucket(req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	// Masking to avoid credential exposure
+	// log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.CreateStorageBucketResult)
+	storageBucket := result.StorageBucket
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(storageBucket.ID))
+	setHashedSecrets(d, "secret_key", "storage_key", "private_key")
+
+	resourceStorageBucketRead(ctx, d, meta)
+	return diags
+}
+
+func resourceStorageBucketRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+
+	resp, err := client.GetStorageBucket(toInt64(id), &morpheus.Request{})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	// Masking to avoid credential exposure
+	// log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	result := resp.Result.(*morpheus.GetStorageBucketResult)
+	storageBucket := result.StorageBucket
+	if storageBucket == nil {
+		d.SetId("")
+		return diags
+	}
+	d.SetId(int64ToString(storageBucket.ID))
+	d.Set("name", storageBucket.Name)
+	d.Set("provider_type", storageBucket.ProviderType)
+	d.Set("bucket_name", storageBucket.BucketName)
+	d.Set("active", storageBucket.Active)
+	d.Set("default_backup_target", storageBucket.DefaultBackupTarget)
+	d.Set("default_deployment_target", storageBucket.DefaultDeploymentTarget)
+	d.Set("default_virtual_image_target", storageBucket.DefaultVirtualImageTarget)
+
+	// The sdk does not include every provider config property
+	var storageBucketPayload StorageBucketPayload
+	if err := json.Unmarshal(resp.Body, &storageBucketPayload); err != nil {
+		return diag.FromErr(err)
+	}
+	bucket := storageBucketPayload.StorageBucket
+	if bucket.Credential.ID != 0 {
+		d.Set("credential_id", bucket.Credential.ID)
+	} else {
+		d.Set("access_key", bucket.Config.AccessKey)
+		d.Set("storage_account", bucket.Config.StorageAccount)
+		d.Set("client_email", bucket.Config.ClientEmail)
+	}
+	d.Set("region", bucket.Config.Region)
+	d.Set("endpoint", bucket.Config.Endpoint)
+	d.Set("project_id", bucket.Config.ProjectId)
+	d.Set("storage_class", bucket.Config.StorageClass)
+	d.Set("base_path", bucket.Config.BasePath)
+
+	return diags
+}
+
+func resourceStorageBucketUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+	id := d.Id()
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"storageBucket": storageBucketPayload(d),
+		},
+	}
+
+	resp, err := client.UpdateStorageBucket(toInt64(id), req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	// Masking to avoid credential exposure
+	// log.Printf("API RESPONSE: %s", resp)
+	setHashedSecrets(d, "secret_key", "storage_key", "private_key")
+
+	return resourceStorageBucketRead(ctx, d, meta)
+}
+
+func resourceStorageBucketDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	req := &morpheus.Request{}
+	resp, err := client.DeleteStorageBucket(toInt64(id), req)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+func storageBucketPayload(d *schema.ResourceData) map[string]interface{} {
+	storageBucket := make(map[string]interface{})
+
+	storageBucket["name"] = d.Get("name").(string)
+	storageBucket["providerType"] = d.Get("provider_type").(string)
+	storageBucket["bucketName"] = d.Get("bucket_name").(string)
+	storageBucket["active"] = d.Get("active").(bool)
+	storageBucket["createBucket"] = d.Get("create_bucket").(bool)
+	storageBucket["defaultBackupTarget"] = d.Get("default_backup_target").(bool)
+	storageBucket["defaultDeploymentTarget"] = d.Get("default_deployment_target").(bool)
+	storageBucket["defaultVirtualImageTarget"] = d.Get("default_virtual_image_target").(bool)
+
+	config := make(map[string]interface{})
+	if v, ok := d.GetOk("credential_id"); ok {
+		storageBucket["credential"] = map[string]interface{}{
+			"type": "credential",
+			"id":   v.(int),
+		}
+	} else {
+		storageBucket["credential"] = map[string]interface{}{
+			"type": "local",
+		}
+	}
+
+	switch d.Get("provider_type").(string) {
+	case "s3":
+		config["region"] = d.Get("region").(string)
+		config["endpoint"] = d.Get("endpoint").(string)
+		if _, ok := d.GetOk("credential_id"); !ok {
+			config["accessKey"] = d.Get("access_key").(string)
+			// The state holds the hash of the secret so it is only sent when changed
+			if d.HasChange("secret_key") {
+				config["secretKey"] = d.Get("secret_key").(string)
+			}
+		}
+	case "azure":
+		if _, ok := d.GetOk("credential_id"); !ok {
+			config["storageAccount"] = d.Get("storage_account").(string)
+			if d.HasChange("storage_key") {
+				config["storageKey"] = d.Get("storage_key").(string)
+			}
+		}
+	case "google":
+		config["projectId"] = d.Get("project_id").(string)
+		config["storageClass"] = d.Get("storage_class").(string)
+		if _, ok := d.GetOk("credential_id"); !ok {
+			config["clientEmail"] = d.Get("client_email").(string)
+			if d.HasChange("private_key") {
+				config["privateKey"] = d.Get("private_key").(string)
+			}
+		}
+	case "local":
+		config["basePath"] = d.Get("base_path").(string)
+	}
+	storageBucket["config"] = config
+
+	return storageBucket
+}
+
+type StorageBucketPayload struct {
+	StorageBucket struct {
+		Credential struct {
+			ID int64 `json:"id"`
+		} `json:"credential"`
+		Config struct {
+			AccessKey      string `json:"accessKey"`
+			Region         string `json:"region"`
+			Endpoint       string `json:"endpoint"`
+			StorageAccount string `json:"storageAccount"`
+			ProjectId      string `json:"projectId"`
+			ClientEmail    string `json:"clientEmail"`
+			StorageClass   string `json:"storageClass"`
+			BasePath       string `json:"basePath"`
+		} `json:"config"`
+	} `json:"storageBucket"`
+}
@@ -0,0 +1,265 @@
+package morpheus
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceStorageServer() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus storage server resource, used to integrate an enterprise storage array",
+		CreateContext: resourceStorageServerCreate,
+		ReadContext:   resourceStorageServerRead,
+		UpdateContext: resourceStorageServerUpdate,
+		DeleteContext: resourceStorageServerDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the storage server",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the storage server",
+				Required:    true,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Description: "The code of the storage server type (i.e. - 3par, pureStorage, netapp)",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "The description of the storage server",
+				Optional:    true,
+			},
+			"visibility": {
+				Type:         schema.TypeString,
+				Description:  "Whether the storage server is visible in sub-tenants or not (private, public)",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"private", "public"}, false),
+				Default:      "private",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Description: "The url of the storage server api",
+				Required:    true,
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Description: "The username used to authenticate with the storage server",
+				Optional:    true,
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Description: "The password used to authenticate with the storage server",
+				Optional:    true,
+				Sensitive:   true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					h := sha256.New()
+					h.Write([]byte(new))
+					sha256_hash := hex.EncodeToString(h.Sum(nil))
+					return strings.EqualFold(old, sha256_hash)
+				},
+				DiffSuppressOnRefresh: true,
+			},
+			"api_token": {
+				Type:        schema.TypeString,
+				Description: "The api token used to authenticate with storage servers that use token authentication",
+				Optional:    true,
+				Sensitive:   true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					h := sha256.New()
+					h.Write([]byte(new))
+					sha256_hash := hex.EncodeToString(h.Sum(nil))
+					return strings.EqualFold(old, sha256_hash)
+				},
+				DiffSuppressOnRefresh: true,
+			},
+			"config": {
+				Type:        schema.TypeMap,
+				Description: "The storage server type specific configuration, keyed by the config property name",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceStorageServerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"storageServer": storageServerPayload(d),
+		},
+	}
+
+	resp, err := client.CreateStorageServer(req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	// Masking to avoid credential exposure
+	// log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.CreateStorageServerResult)
+	storageServer := result.StorageServer
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(storageServer.ID))
+
+	resourceStorageServerRead(ctx, d, meta)
+	return diags
+}
+
+func resourceStorageServerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+
+	resp, err := client.GetStorageServer(toInt64(id), &morpheus.Request{})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	// Masking to avoid credential exposure
+	// log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	result := resp.Result.(*morpheus.GetStorageServerResult)
+	storageServer := result.StorageServer
+	if storageServer == nil {
+		d.SetId("")
+		return diags
+	}
+	d.SetId(int64ToString(storageServer.ID))
+	d.Set("name", storageServer.Name)
+	d.Set("type", storageServer.Type.Code)
+	d.Set("description", storageServer.Description)
+	d.Set("visibility", storageServer.Visibility)
+	d.Set("url", storageServer.ServiceUrl)
+	d.Set("username", storageServer.ServiceUsername)
+	d.Set("password", storageServer.ServicePasswordHash)
+	d.Set("api_token", storageServer.ServiceTokenHash)
+
+	// The sdk only includes a subset of the config so the
+	// configured keys are read from the raw response
+	var storageServerPayload StorageServerPayload
+	if err := json.Unmarshal(resp.Body, &storageServerPayload); err != nil {
+		return diag.FromErr(err)
+	}
+	config := make(map[string]interface{})
+	for k := range d.Get("config").(map[string]interface{}) {
+		if v, ok := storageServerPayload.StorageServer.Config[k]; ok && v != nil {
+			config[k] = fmt.Sprintf("%v", v)
+		}
+	}
+	d.Set("config", config)
+
+	return diags
+}
+
+func resourceStorageServerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+	id := d.Id()
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"storageServer": storageServerPayload(d),
+		},
+	}
+
+	resp, err := client.UpdateStorageServer(toInt64(id), req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	// Masking to avoid credential exposure
+	// log.Printf("API RESPONSE: %s", resp)
+
+	return resourceStorageServerRead(ctx, d, meta)
+}
+
+func resourceStorageServerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	req := &morpheus.Request{}
+	resp, err := client.DeleteStorageServer(toInt64(id), req)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+func storageServerPayload(d *schema.ResourceData) map[string]interface{} {
+	storageServer := make(map[string]interface{})
+
+	storageServer["name"] = d.Get("name").(string)
+	storageServer["type"] = d.Get("type").(string)
+	storageServer["description"] = d.Get("description").(string)
+	storageServer["visibility"] = d.Get("visibility").(string)
+	storageServer["serviceUrl"] = d.Get("url").(string)
+	storageServer["serviceUsername"] = d.Get("username").(string)
+	// The state holds the hashes of the secrets so they are only sent when changed
+	if d.HasChange("password") {
+		storageServer["servicePassword"] = d.Get("password").(string)
+	}
+	if d.HasChange("api_token") {
+		storageServer["serviceToken"] = d.Get("api_token").(string)
+	}
+
+	config := make(map[string]interface{})
+	for k, v := range d.Get("config").(map[string]interface{}) {
+		config[k] = v.(string)
+	}
+	storageServer["config"] = config
+
+	return storageServer
+}
+
+type StorageServerPayload struct {
+	StorageServer struct {
+		Config map[string]interface{} `json:"config"`
+	} `json:"storageServer"`
+}
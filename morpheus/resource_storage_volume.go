@@ -0,0 +1,316 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var (
+	// StorageVolumesPath is the API endpoint for storage volumes
+	StorageVolumesPath = "/api/storage-volumes"
+)
+
+func resourceStorageVolume() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus storage volume resource, used to create a datastore volume on a storage server",
+		CreateContext: resourceStorageVolumeCreate,
+		ReadContext:   resourceStorageVolumeRead,
+		UpdateContext: resourceStorageVolumeUpdate,
+		DeleteContext: resourceStorageVolumeDelete,
+		CustomizeDiff: forceNewOnStorageVolumeShrink,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the storage volume",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the storage volume",
+				Required:    true,
+			},
+			"storage_server_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the storage server the volume is created on",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"storage_group_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the storage group (pool) of the storage server the volume is created in",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Description: "The code of the storage volume type",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"size": {
+				Type:         schema.TypeInt,
+				Description:  "The size of the storage volume in GB, decreasing the size replaces the storage volume",
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"cloud_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the cloud the volume is presented to as a datastore",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"visibility": {
+				Type:         schema.TypeString,
+				Description:  "Whether the storage volume is visible in sub-tenants or not (private, public)",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"private", "public"}, false),
+				Default:      "private",
+			},
+			"group_access_all": {
+				Type:        schema.TypeBool,
+				Description: "Whether to grant all groups access to the datastore of the storage volume",
+				Optional:    true,
+				Default:     false,
+			},
+			"group_access_ids": {
+				Type:        schema.TypeSet,
+				Description: "A list of group ids to grant access to the datastore of the storage volume",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"datastore_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the datastore backed by the storage volume, used as the datastore_id of instance volumes",
+				Computed:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceStorageVolumeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	storageVolume := storageVolumePayload(d)
+	storageVolume["type"] = d.Get("type").(string)
+	storageVolume["storageServer"] = map[string]interface{}{
+		"id": d.Get("storage_server_id").(int),
+	}
+	if v, ok := d.GetOk("storage_group_id"); ok {
+		storageVolume["storageGroup"] = map[string]interface{}{
+			"id": v.(int),
+		}
+	}
+	if v, ok := d.GetOk("cloud_id"); ok {
+		storageVolume["zone"] = map[string]interface{}{
+			"id": v.(int),
+		}
+	}
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"storageVolume": storageVolume,
+		},
+	}
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method:      "POST",
+		Path:        StorageVolumesPath,
+		QueryParams: map[string]string{},
+		Body:        req.Body,
+		Result:      &StorageVolumeResult{},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*StorageVolumeResult)
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(result.StorageVolume.ID))
+
+	resourceStorageVolumeRead(ctx, d, meta)
+	return diags
+}
+
+func resourceStorageVolumeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method:      "GET",
+		Path:        fmt.Sprintf("%s/%s", StorageVolumesPath, id),
+		QueryParams: map[string]string{},
+		Result:      &StorageVolumeResult{},
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	result := resp.Result.(*StorageVolumeResult)
+	storageVolume := result.StorageVolume
+	d.SetId(int64ToString(storageVolume.ID))
+	d.Set("name", storageVolume.Name)
+	d.Set("storage_server_id", storageVolume.StorageServer.ID)
+	d.Set("storage_group_id", storageVolume.StorageGroup.ID)
+	d.Set("type", storageVolume.Type.Code)
+	d.Set("size", storageVolume.MaxStorage/(1024*1024*1024))
+	if storageVolume.Zone.ID != 0 {
+		d.Set("cloud_id", storageVolume.Zone.ID)
+	}
+	d.Set("visibility", storageVolume.Visibility)
+	d.Set("group_access_all", storageVolume.ResourcePermission.All)
+	var groupIds []int64
+	for _, site := range storageVolume.ResourcePermission.Sites {
+		groupIds = append(groupIds, site.ID)
+	}
+	d.Set("group_access_ids", groupIds)
+	d.Set("datastore_id", storageVolume.Datastore.ID)
+
+	return diags
+}
+
+func resourceStorageVolumeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+	id := d.Id()
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"storageVolume": storageVolumePayload(d),
+		},
+	}
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method:      "PUT",
+		Path:        fmt.Sprintf("%s/%s", StorageVolumesPath, id),
+		QueryParams: map[string]string{},
+		Body:        req.Body,
+		Result:      &StorageVolumeResult{},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	return resourceStorageVolumeRead(ctx, d, meta)
+}
+
+func resourceStorageVolumeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	resp, err := client.Execute(&morpheus.Request{
+		Method:      "DELETE",
+		Path:        fmt.Sprintf("%s/%s", StorageVolumesPath, id),
+		QueryParams: map[string]string{},
+		Result:      &morpheus.DeleteResult{},
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+// forceNewOnStorageVolumeShrink replaces the storage volume when the size is
+// decreased since storage arrays can only grow a volume
+func forceNewOnStorageVolumeShrink(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && d.HasChange("size") {
+		o, n := d.GetChange("size")
+		if n.(int) < o.(int) {
+			return d.ForceNew("size")
+		}
+	}
+	return nil
+}
+
+// storageVolumePayload builds the attributes of the storage volume that
+// can be set on both create and update
+func storageVolumePayload(d *schema.ResourceData) map[string]interface{} {
+	storageVolume := make(map[string]interface{})
+
+	storageVolume["name"] = d.Get("name").(string)
+	storageVolume["maxStorage"] = int64(d.Get("size").(int)) * 1024 * 1024 * 1024
+	storageVolume["visibility"] = d.Get("visibility").(string)
+
+	var groupIds []map[string]interface{}
+	for _, v := range d.Get("group_access_ids").(*schema.Set).List() {
+		groupIds = append(groupIds, map[string]interface{}{
+			"id": v,
+		})
+	}
+	storageVolume["resourcePermissions"] = map[string]interface{}{
+		"all":   d.Get("group_access_all").(bool),
+		"sites": groupIds,
+	}
+
+	return storageVolume
+}
+
+type StorageVolumeResult struct {
+	Success       bool              `json:"success"`
+	Message       string            `json:"msg"`
+	Errors        map[string]string `json:"errors"`
+	StorageVolume struct {
+		ID   int64  `json:"id"`
+		Name string `json:"name"`
+		Type struct {
+			Code string `json:"code"`
+		} `json:"type"`
+		MaxStorage    int64 `json:"maxStorage"`
+		StorageServer struct {
+			ID int64 `json:"id"`
+		} `json:"storageServer"`
+		StorageGroup struct {
+			ID int64 `json:"id"`
+		} `json:"storageGroup"`
+		Zone struct {
+			ID int64 `json:"id"`
+		} `json:"zone"`
+		Datastore struct {
+			ID int64 `json:"id"`
+		} `json:"datastore"`
+		Visibility         string                   `json:"visibility"`
+		ResourcePermission CloudResourcePermissions `json:"resourcePermission"`
+	} `json:"storageVolume"`
+}
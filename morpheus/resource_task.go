@@ -0,0 +1,352 @@
+package morpheus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var (
+	// TaskTypesPath is the API endpoint for task types
+	TaskTypesPath = "/api/task-types"
+)
+
+func resourceTask() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a generic Morpheus task resource for any task type, including task types provided by plugins",
+		CreateContext: resourceTaskCreate,
+		ReadContext:   resourceTaskRead,
+		UpdateContext: resourceTaskUpdate,
+		DeleteContext: resourceTaskDelete,
+		CustomizeDiff: validateTaskOptions,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the task",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the task",
+				Required:    true,
+			},
+			"code": {
+				Type:        schema.TypeString,
+				Description: "The code of the task",
+				Optional:    true,
+				Computed:    true,
+			},
+			"task_type_code": {
+				Type:        schema.TypeString,
+				Description: "The code of the task type (i.e. - script, jythonTask, restTask or the code of a plugin task type)",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"labels": {
+				Type:        schema.TypeSet,
+				Description: "The organization labels associated with the task (Only supported on Morpheus 5.5.3 or higher)",
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"visibility": {
+				Type:         schema.TypeString,
+				Description:  "The visibility of the task (private or public)",
+				ValidateFunc: validation.StringInSlice([]string{"private", "public"}, false),
+				Optional:     true,
+				Computed:     true,
+			},
+			"result_type": {
+				Type:         schema.TypeString,
+				Description:  "The expected result type (value, keyValue, json)",
+				ValidateFunc: validation.StringInSlice([]string{"value", "keyValue", "json"}, false),
+				Optional:     true,
+				Computed:     true,
+			},
+			"execute_target": {
+				Type:         schema.TypeString,
+				Description:  "The execute target of the task (local, remote, resource)",
+				ValidateFunc: validation.StringInSlice([]string{"local", "remote", "resource"}, false),
+				Optional:     true,
+				Computed:     true,
+			},
+			"retryable": {
+				Type:        schema.TypeBool,
+				Description: "Whether to retry the task if there is a failure",
+				Optional:    true,
+				Default:     false,
+			},
+			"retry_count": {
+				Type:        schema.TypeInt,
+				Description: "The number of times to retry the task if there is a failure",
+				Optional:    true,
+				Default:     5,
+			},
+			"retry_delay_seconds": {
+				Type:        schema.TypeInt,
+				Description: "The number of seconds to wait between retry attempts",
+				Optional:    true,
+				Default:     10,
+			},
+			"allow_custom_config": {
+				Type:        schema.TypeBool,
+				Description: "Whether to allow custom configuration data to be passed during the execution of the task",
+				Optional:    true,
+				Default:     false,
+			},
+			"task_options": {
+				Type:        schema.TypeMap,
+				Description: "The task type specific options, keyed by the field name of the task type option types. The keys are validated against the task type during the plan",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"task": taskPayload(d),
+		},
+	}
+	resp, err := client.CreateTask(req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.CreateTaskResult)
+	task := result.Task
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(task.ID))
+
+	resourceTaskRead(ctx, d, meta)
+	return diags
+}
+
+func resourceTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+
+	resp, err := client.GetTask(toInt64(id), &morpheus.Request{})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	result := resp.Result.(*morpheus.GetTaskResult)
+	task := result.Task
+	if task == nil {
+		d.SetId("")
+		return diags
+	}
+	d.SetId(int64ToString(task.ID))
+	d.Set("name", task.Name)
+	d.Set("code", task.Code)
+	d.Set("task_type_code", task.TaskType.Code)
+	d.Set("labels", task.Labels)
+	d.Set("visibility", task.Visibility)
+	d.Set("result_type", task.ResultType)
+	d.Set("execute_target", task.ExecuteTarget)
+	d.Set("retryable", task.Retryable)
+	d.Set("retry_count", task.RetryCount)
+	d.Set("retry_delay_seconds", task.RetryDelaySeconds)
+	d.Set("allow_custom_config", task.AllowCustomConfig)
+
+	// The sdk only includes the options of the built in task types
+	// so the configured keys are read from the raw response
+	var taskPayload TaskPayload
+	if err := json.Unmarshal(resp.Body, &taskPayload); err != nil {
+		return diag.FromErr(err)
+	}
+	taskOptions := make(map[string]interface{})
+	for k, v := range d.Get("task_options").(map[string]interface{}) {
+		// Secrets are not returned so the configured value is retained
+		if option, ok := taskPayload.Task.TaskOptions[k]; ok && option != nil && fmt.Sprintf("%v", option) != "" {
+			taskOptions[k] = fmt.Sprintf("%v", option)
+		} else {
+			taskOptions[k] = v
+		}
+	}
+	d.Set("task_options", taskOptions)
+
+	return diags
+}
+
+func resourceTaskUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+	id := d.Id()
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"task": taskPayload(d),
+		},
+	}
+	resp, err := client.UpdateTask(toInt64(id), req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	return resourceTaskRead(ctx, d, meta)
+}
+
+func resourceTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	req := &morpheus.Request{}
+	resp, err := client.DeleteTask(toInt64(id), req)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+func taskPayload(d *schema.ResourceData) map[string]interface{} {
+	task := make(map[string]interface{})
+
+	task["name"] = d.Get("name").(string)
+	task["code"] = d.Get("code").(string)
+	task["taskType"] = map[string]interface{}{
+		"code": d.Get("task_type_code").(string),
+	}
+
+	labelsPayload := make([]string, 0)
+	if attr, ok := d.GetOk("labels"); ok {
+		for _, s := range attr.(*schema.Set).List() {
+			labelsPayload = append(labelsPayload, s.(string))
+		}
+	}
+	task["labels"] = labelsPayload
+
+	if v, ok := d.GetOk("visibility"); ok {
+		task["visibility"] = v.(string)
+	}
+	if v, ok := d.GetOk("result_type"); ok {
+		task["resultType"] = v.(string)
+	}
+	if v, ok := d.GetOk("execute_target"); ok {
+		task["executeTarget"] = v.(string)
+	}
+	task["retryable"] = d.Get("retryable").(bool)
+	task["retryCount"] = d.Get("retry_count").(int)
+	task["retryDelaySeconds"] = d.Get("retry_delay_seconds").(int)
+	task["allowCustomConfig"] = d.Get("allow_custom_config").(bool)
+
+	taskOptions := make(map[string]interface{})
+	for k, v := range d.Get("task_options").(map[string]interface{}) {
+		taskOptions[k] = v.(string)
+	}
+	task["taskOptions"] = taskOptions
+
+	return task
+}
+
+// validateTaskOptions checks the task options against the option types of
+// the task type on the appliance so unknown or missing options are
+// reported during the plan
+func validateTaskOptions(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("task_type_code") || !d.NewValueKnown("task_options") {
+		return nil
+	}
+	client := meta.(*morpheus.Client)
+	taskTypeCode := d.Get("task_type_code").(string)
+
+	taskTypes, err := listAllObjects(client, TaskTypesPath, "taskTypes", map[string]string{"code": taskTypeCode})
+	if err != nil {
+		log.Printf("API FAILURE: %s", err)
+		return err
+	}
+	var taskType map[string]interface{}
+	for _, object := range taskTypes {
+		if objectString(object, "code") == taskTypeCode {
+			taskType = object
+			break
+		}
+	}
+	if taskType == nil {
+		return fmt.Errorf("task type %s was not found", taskTypeCode)
+	}
+
+	taskOptions := d.Get("task_options").(map[string]interface{})
+	optionTypes := objectValues(taskType, "optionTypes")
+	fieldNames := make(map[string]bool)
+	for _, optionType := range optionTypes {
+		fieldContext := objectString(optionType, "fieldContext")
+		if fieldContext != "" && fieldContext != "taskOptions" {
+			continue
+		}
+		fieldName := objectString(optionType, "fieldName")
+		fieldNames[fieldName] = true
+		if objectString(optionType, "required") != "true" || objectString(optionType, "defaultValue") != "" {
+			continue
+		}
+		if v, ok := taskOptions[fieldName]; !ok || v.(string) == "" {
+			return fmt.Errorf("task_options %s is required by the %s task type", fieldName, taskTypeCode)
+		}
+	}
+
+	for k := range taskOptions {
+		if !fieldNames[k] {
+			var validKeys []string
+			for fieldName := range fieldNames {
+				validKeys = append(validKeys, fieldName)
+			}
+			sort.Strings(validKeys)
+			return fmt.Errorf("task_options %s is not an option of the %s task type, expected one of: %s", k, taskTypeCode, strings.Join(validKeys, ", "))
+		}
+	}
+	return nil
+}
+
+type TaskPayload struct {
+	Task struct {
+		TaskOptions map[string]interface{} `json:"taskOptions"`
+	} `json:"task"`
+}
@@ -0,0 +1,279 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceTaskExecution() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus task execution resource, the task is executed when the resource is created and executed again when any of the arguments change",
+		CreateContext: resourceTaskExecutionCreate,
+		ReadContext:   resourceJobExecutionRead,
+		DeleteContext: resourceJobExecutionDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: jobExecutionSchema("task_id", "The ID of the task to execute"),
+	}
+}
+
+func resourceTaskExecutionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	path := fmt.Sprintf("%s/%d/execute", morpheus.TasksPath, d.Get("task_id").(int))
+	return executeJob(ctx, d, meta, path)
+}
+
+// jobExecutionSchema returns the schema shared by the task and workflow
+// execution resources, every argument forces a new execution
+func jobExecutionSchema(idAttribute string, idDescription string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Description: "The ID of the job execution",
+			Computed:    true,
+		},
+		idAttribute: {
+			Type:        schema.TypeInt,
+			Description: idDescription,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"target_type": {
+			Type:         schema.TypeString,
+			Description:  "The type of the target the job is executed against (none, instance, server, app)",
+			Optional:     true,
+			ForceNew:     true,
+			Default:      "none",
+			ValidateFunc: validation.StringInSlice([]string{"none", "instance", "server", "app"}, false),
+		},
+		"target_ids": {
+			Type:        schema.TypeList,
+			Description: "The IDs of the instances, servers or apps the job is executed against",
+			Optional:    true,
+			ForceNew:    true,
+			Elem:        &schema.Schema{Type: schema.TypeInt},
+		},
+		"custom_options": {
+			Type:        schema.TypeMap,
+			Description: "The custom options passed to the execution, keyed by the field name of the option type",
+			Optional:    true,
+			ForceNew:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"triggers": {
+			Type:        schema.TypeMap,
+			Description: "Arbitrary values that cause the job to be executed again when they change",
+			Optional:    true,
+			ForceNew:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Description: "The status of the execution",
+			Computed:    true,
+		},
+		"output": {
+			Type:        schema.TypeString,
+			Description: "The output of the execution",
+			Computed:    true,
+		},
+		"error_output": {
+			Type:        schema.TypeString,
+			Description: "The error output of the execution",
+			Computed:    true,
+		},
+		"result_data": {
+			Type:        schema.TypeString,
+			Description: "The result data of the execution",
+			Computed:    true,
+		},
+	}
+}
+
+// executeJob executes the task or workflow at the path and waits for the
+// job execution to complete, a failed execution fails the apply
+func executeJob(ctx context.Context, d *schema.ResourceData, meta interface{}, path string) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	targetType := d.Get("target_type").(string)
+	var targetIds []int
+	for _, v := range d.Get("target_ids").([]interface{}) {
+		targetIds = append(targetIds, v.(int))
+	}
+	if targetType != "none" && len(targetIds) == 0 {
+		return diag.Errorf("target_ids is required when the target_type is %s", targetType)
+	}
+
+	job := make(map[string]interface{})
+	switch targetType {
+	case "instance":
+		job["targetType"] = "instance"
+		job["instances"] = targetIds
+	case "server":
+		job["targetType"] = "server"
+		job["servers"] = targetIds
+	case "app":
+		job["targetType"] = "app"
+		job["apps"] = targetIds
+	default:
+		job["targetType"] = "appliance"
+	}
+	customOptions := make(map[string]interface{})
+	for k, v := range d.Get("custom_options").(map[string]interface{}) {
+		customOptions[k] = v.(string)
+	}
+	job["customOptions"] = customOptions
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method:      "POST",
+		Path:        path,
+		QueryParams: map[string]string{},
+		Body: map[string]interface{}{
+			"job": job,
+		},
+		Result: &JobExecuteResult{},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*JobExecuteResult)
+	if result.JobExecution.ID == 0 {
+		return diag.Errorf("the execution was started but the job execution was not returned")
+	}
+	// Successfully started the execution, now set id
+	d.SetId(int64ToString(result.JobExecution.ID))
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"new", "queued", "pending", "running", "in-progress"},
+		Target:  []string{"complete", "completed", "success"},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.GetJobExecution(result.JobExecution.ID, &morpheus.Request{})
+			if err != nil {
+				return "", "", err
+			}
+			jobExecution := resp.Result.(*morpheus.GetJobExecutionResult).JobExecution
+			if jobExecution == nil {
+				return "", "", fmt.Errorf("job execution %d not found", result.JobExecution.ID)
+			}
+			return jobExecution, strings.ToLower(jobExecution.Status), nil
+		},
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        10 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	raw, err := stateConf.WaitForStateContext(ctx)
+	if jobExecution, ok := raw.(*morpheus.JobExecution); ok && jobExecution != nil {
+		setJobExecution(d, jobExecution)
+		if err != nil {
+			return diag.Errorf("job execution %d finished with status %s: %s", jobExecution.ID, jobExecution.Status, jobExecutionErrorOutput(jobExecution))
+		}
+	}
+	if err != nil {
+		return diag.Errorf("error waiting for job execution (%s) to complete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceJobExecutionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+
+	resp, err := client.GetJobExecution(toInt64(id), &morpheus.Request{})
+	if err != nil {
+		// The execution history is purged by the appliance, the job is
+		// not executed again just because the record no longer exists
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	result := resp.Result.(*morpheus.GetJobExecutionResult)
+	if result.JobExecution != nil {
+		setJobExecution(d, result.JobExecution)
+	}
+	return diags
+}
+
+func resourceJobExecutionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	// The execution has already happened so it is only removed from the state
+	d.SetId("")
+	return diags
+}
+
+func setJobExecution(d *schema.ResourceData, jobExecution *morpheus.JobExecution) {
+	d.Set("status", jobExecution.Status)
+	d.Set("result_data", jobExecution.ResultData)
+
+	// Workflow executions report the output of each task as an event
+	output := jobExecution.Process.Output
+	if output == "" {
+		var outputs []string
+		for _, event := range jobExecution.Process.Events {
+			if event.Output != "" {
+				outputs = append(outputs, event.Output)
+			}
+		}
+		output = strings.Join(outputs, "\n")
+	}
+	d.Set("output", output)
+	d.Set("error_output", jobExecutionErrorOutput(jobExecution))
+}
+
+// jobExecutionErrorOutput collects the error output of the execution and
+// of the tasks it executed
+func jobExecutionErrorOutput(jobExecution *morpheus.JobExecution) string {
+	var errors []string
+	if jobExecution.Process.Error != "" {
+		errors = append(errors, jobExecution.Process.Error)
+	}
+	for _, event := range jobExecution.Process.Events {
+		if event.Error != "" {
+			errors = append(errors, fmt.Sprintf("%s: %s", event.DisplayName, event.Error))
+		}
+	}
+	if len(errors) == 0 && jobExecution.StatusMessage != "" {
+		errors = append(errors, jobExecution.StatusMessage)
+	}
+	return strings.Join(errors, "\n")
+}
+
+type JobExecuteResult struct {
+	Success      bool              `json:"success"`
+	Message      string            `json:"msg"`
+	Errors       map[string]string `json:"errors"`
+	JobExecution struct {
+		ID int64 `json:"id"`
+	} `json:"jobExecution"`
+}
@@ -0,0 +1,320 @@
+package morpheus
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceVaultIntegration() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a HashiCorp Vault integration resource, used as a credential store and as the backend of the vault cypher mount",
+		CreateContext: resourceVaultIntegrationCreate,
+		ReadContext:   resourceVaultIntegrationRead,
+		UpdateContext: resourceVaultIntegrationUpdate,
+		DeleteContext: resourceVaultIntegrationDelete,
+		CustomizeDiff: validateVaultIntegrationAuth,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the vault integration",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the vault integration",
+				Required:    true,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether the vault integration is enabled",
+				Optional:    true,
+				Default:     true,
+			},
+			"url": {
+				Type:         schema.TypeString,
+				Description:  "The url of the vault server (i.e. - https://vault.example.com:8200)",
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"namespace": {
+				Type:        schema.TypeString,
+				Description: "The vault enterprise namespace",
+				Optional:    true,
+			},
+			"auth_method": {
+				Type:         schema.TypeString,
+				Description:  "The method used to authenticate with vault (token, approle)",
+				Optional:     true,
+				Default:      "token",
+				ValidateFunc: validation.StringInSlice([]string{"token", "approle"}, false),
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Description: "The vault token used when the auth method is token",
+				Optional:    true,
+				Sensitive:   true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					h := sha256.New()
+					h.Write([]byte(new))
+					sha256_hash := hex.EncodeToString(h.Sum(nil))
+					return strings.EqualFold(old, sha256_hash)
+				},
+			},
+			"approle_mount_path": {
+				Type:        schema.TypeString,
+				Description: "The path the AppRole auth method is mounted at",
+				Optional:    true,
+				Default:     "approle",
+			},
+			"role_id": {
+				Type:        schema.TypeString,
+				Description: "The AppRole role id used when the auth method is approle",
+				Optional:    true,
+			},
+			"secret_id": {
+				Type:        schema.TypeString,
+				Description: "The AppRole secret id used when the auth method is approle",
+				Optional:    true,
+				Sensitive:   true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					h := sha256.New()
+					h.Write([]byte(new))
+					sha256_hash := hex.EncodeToString(h.Sum(nil))
+					return strings.EqualFold(old, sha256_hash)
+				},
+			},
+			"mount_path": {
+				Type:        schema.TypeString,
+				Description: "The path the KV secrets engine is mounted at",
+				Optional:    true,
+				Default:     "secret",
+			},
+			"kv_version": {
+				Type:         schema.TypeInt,
+				Description:  "The version of the KV secrets engine (1, 2)",
+				Optional:     true,
+				Default:      2,
+				ValidateFunc: validation.IntInSlice([]int{1, 2}),
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceVaultIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"integration": vaultIntegrationPayload(d),
+		},
+	}
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method:      "POST",
+		Path:        morpheus.IntegrationsPath,
+		QueryParams: map[string]string{},
+		Body:        req.Body,
+		Result:      &GenericIntegrationResult{},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	// Masking to avoid credential exposure
+	// log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*GenericIntegrationResult)
+	integrationResult := result.Integration
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(integrationResult.ID))
+	setHashedSecrets(d, "token", "secret_id")
+
+	resourceVaultIntegrationRead(ctx, d, meta)
+	return diags
+}
+
+func resourceVaultIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method:      "GET",
+		Path:        fmt.Sprintf("%s/%s", morpheus.IntegrationsPath, id),
+		QueryParams: map[string]string{},
+		Result:      &GenericIntegrationResult{},
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	// Masking to avoid credential exposure
+	// log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	result := resp.Result.(*GenericIntegrationResult)
+	integration := result.Integration
+	d.SetId(int64ToString(integration.ID))
+	d.Set("name", integration.Name)
+	d.Set("enabled", integration.Enabled)
+	d.Set("url", integration.ServiceUrl)
+
+	config := integration.Config
+	if v, ok := config["namespace"].(string); ok {
+		d.Set("namespace", v)
+	}
+	if v, ok := config["authMethod"].(string); ok && v != "" {
+		d.Set("auth_method", v)
+	}
+	if v, ok := config["authMountPath"].(string); ok && v != "" {
+		d.Set("approle_mount_path", v)
+	}
+	if v, ok := config["roleId"].(string); ok {
+		d.Set("role_id", v)
+	}
+	if v, ok := config["secretPath"].(string); ok && v != "" {
+		d.Set("mount_path", v)
+	}
+	if v, ok := config["secretEngine"].(string); ok && v != "" {
+		if v == "kv" {
+			d.Set("kv_version", 1)
+		} else {
+			d.Set("kv_version", 2)
+		}
+	}
+
+	return diags
+}
+
+func resourceVaultIntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+	id := d.Id()
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"integration": vaultIntegrationPayload(d),
+		},
+	}
+
+	resp, err := client.Execute(&morpheus.Request{
+		Method:      "PUT",
+		Path:        fmt.Sprintf("%s/%s", morpheus.IntegrationsPath, id),
+		QueryParams: map[string]string{},
+		Body:        req.Body,
+		Result:      &GenericIntegrationResult{},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	// Masking to avoid credential exposure
+	// log.Printf("API RESPONSE: %s", resp)
+	setHashedSecrets(d, "token", "secret_id")
+
+	return resourceVaultIntegrationRead(ctx, d, meta)
+}
+
+func resourceVaultIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	req := &morpheus.Request{}
+	resp, err := client.DeleteIntegration(toInt64(id), req)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return diag.FromErr(err)
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+func vaultIntegrationPayload(d *schema.ResourceData) map[string]interface{} {
+	integration := make(map[string]interface{})
+
+	integration["name"] = d.Get("name").(string)
+	integration["enabled"] = d.Get("enabled").(bool)
+	integration["type"] = "hashicorpVault"
+	integration["serviceUrl"] = d.Get("url").(string)
+
+	config := make(map[string]interface{})
+	config["namespace"] = d.Get("namespace").(string)
+	config["authMethod"] = d.Get("auth_method").(string)
+	config["secretPath"] = d.Get("mount_path").(string)
+	if d.Get("kv_version").(int) == 1 {
+		config["secretEngine"] = "kv"
+	} else {
+		config["secretEngine"] = "kv2"
+	}
+
+	// The state holds the hashes of the secrets so they are only sent when changed
+	switch d.Get("auth_method").(string) {
+	case "approle":
+		config["authMountPath"] = d.Get("approle_mount_path").(string)
+		config["roleId"] = d.Get("role_id").(string)
+		if d.HasChange("secret_id") {
+			config["secretId"] = d.Get("secret_id").(string)
+		}
+	default:
+		if d.HasChange("token") {
+			integration["serviceToken"] = d.Get("token").(string)
+		}
+	}
+	integration["config"] = config
+
+	return integration
+}
+
+// validateVaultIntegrationAuth ensures the credentials required by the
+// auth method are configured so the error is raised during the plan
+func validateVaultIntegrationAuth(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	switch d.Get("auth_method").(string) {
+	case "approle":
+		if d.NewValueKnown("role_id") && d.Get("role_id").(string) == "" {
+			return fmt.Errorf("role_id is required when the auth_method is approle")
+		}
+		if d.NewValueKnown("secret_id") && d.Get("secret_id").(string) == "" {
+			return fmt.Errorf("secret_id is required when the auth_method is approle")
+		}
+	default:
+		if d.NewValueKnown("token") && d.Get("token").(string) == "" {
+			return fmt.Errorf("token is required when the auth_method is token")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,525 @@
+package morpheus
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"log"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceVirtualImage() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus virtual image resource, the image content is uploaded from a local file or a url",
+		CreateContext: resourceVirtualImageCreate,
+		ReadContext:   resourceVirtualImageRead,
+		UpdateContext: resourceVirtualImageUpdate,
+		DeleteContext: resourceVirtualImageDelete,
+		CustomizeDiff: setVirtualImageSourceChecksum,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the virtual image",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the virtual image",
+				Required:    true,
+			},
+			"image_type": {
+				Type:        schema.TypeString,
+				Description: "The type of the virtual image (i.e. - qcow2, vmdk, ova, vhd, iso, raw)",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"os_type": {
+				Type:        schema.TypeString,
+				Description: "The code of the operating system type of the virtual image (i.e. - ubuntu.22.04.64, windows.server.2022)",
+				Optional:    true,
+			},
+			"minimum_memory": {
+				Type:         schema.TypeInt,
+				Description:  "The minimum amount of memory in MB required by the virtual image",
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"minimum_disk": {
+				Type:         schema.TypeInt,
+				Description:  "The minimum disk size in GB required by the virtual image",
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"is_cloud_init": {
+				Type:        schema.TypeBool,
+				Description: "Whether the virtual image supports cloud-init",
+				Optional:    true,
+				Default:     false,
+			},
+			"is_sysprep": {
+				Type:        schema.TypeBool,
+				Description: "Whether the virtual image has been sysprepped",
+				Optional:    true,
+				Default:     false,
+			},
+			"install_agent": {
+				Type:        schema.TypeBool,
+				Description: "Whether the Morpheus agent is installed on instances provisioned from the virtual image",
+				Optional:    true,
+				Default:     true,
+			},
+			"storage_provider_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the storage bucket the virtual image files are stored in",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"visibility": {
+				Type:         schema.TypeString,
+				Description:  "Whether the virtual image is visible in sub-tenants or not (private, public)",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"private", "public"}, false),
+				Default:      "private",
+			},
+			"source": {
+				Type:         schema.TypeString,
+				Description:  "The path of the local file uploaded as the virtual image content",
+				Optional:     true,
+				ExactlyOneOf: []string{"source", "source_url"},
+			},
+			"source_url": {
+				Type:         schema.TypeString,
+				Description:  "The url the virtual image content is downloaded from by the Morpheus appliance",
+				Optional:     true,
+				ExactlyOneOf: []string{"source", "source_url"},
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"filename": {
+				Type:        schema.TypeString,
+				Description: "The name of the uploaded file, defaults to the base name of the source or source_url",
+				Optional:    true,
+				Computed:    true,
+			},
+			"checksum": {
+				Type:        schema.TypeString,
+				Description: "The checksum of the virtual image content, a change re-uploads the content. Defaults to the sha256 of the source file, set it explicitly when using source_url",
+				Optional:    true,
+				Computed:    true,
+			},
+			"source_fingerprint": {
+				Type:        schema.TypeString,
+				Description: "The size and modification time of the source file when its checksum was computed, the source file is only hashed again when they change",
+				Computed:    true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceVirtualImageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"virtualImage": virtualImagePayload(d),
+		},
+	}
+
+	resp, err := client.CreateVirtualImage(req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	result := resp.Result.(*morpheus.CreateVirtualImageResult)
+	virtualImage := result.VirtualImage
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(virtualImage.ID))
+
+	filename := virtualImageFilename(d)
+	if err := uploadVirtualImageContent(ctx, client, virtualImage.ID, d, filename); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("filename", filename)
+
+	resourceVirtualImageRead(ctx, d, meta)
+	return diags
+}
+
+func resourceVirtualImageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+
+	resp, err := client.GetVirtualImage(toInt64(id), &morpheus.Request{})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			log.Printf("Forcing recreation of resource")
+			d.SetId("")
+			return diags
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	// store resource data
+	result := resp.Result.(*morpheus.GetVirtualImageResult)
+	virtualImage := result.VirtualImage
+	if virtualImage == nil {
+		d.SetId("")
+		return diags
+	}
+	d.SetId(int64ToString(virtualImage.ID))
+	d.Set("name", virtualImage.Name)
+	d.Set("image_type", virtualImage.ImageType)
+	d.Set("os_type", virtualImage.OsType.Code)
+	d.Set("minimum_memory", virtualImage.MinRam/(1024*1024))
+	d.Set("minimum_disk", virtualImage.MinDisk/(1024*1024*1024))
+	d.Set("is_cloud_init", virtualImage.IsCloudInit)
+	d.Set("is_sysprep", virtualImage.IsSysprep)
+	d.Set("install_agent", virtualImage.InstallAgent)
+	d.Set("visibility", virtualImage.Visibility)
+
+	// The sdk types the storage provider loosely so the
+	// id is read from the raw response
+	var virtualImagePayload VirtualImagePayload
+	if err := json.Unmarshal(resp.Body, &virtualImagePayload); err != nil {
+		return diag.FromErr(err)
+	}
+	if virtualImagePayload.VirtualImage.StorageProvider.ID != 0 {
+		d.Set("storage_provider_id", virtualImagePayload.VirtualImage.StorageProvider.ID)
+	}
+
+	return diags
+}
+
+func resourceVirtualImageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+	id := d.Id()
+
+	req := &morpheus.Request{
+		Body: map[string]interface{}{
+			"virtualImage": virtualImagePayload(d),
+		},
+	}
+
+	resp, err := client.UpdateVirtualImage(toInt64(id), req)
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	if d.HasChanges("source", "source_url", "filename", "checksum") {
+		filename := virtualImageFilename(d)
+		// Remove the previous file when it would not be replaced by the upload
+		o, _ := d.GetChange("filename")
+		oldFilename := o.(string)
+		if oldFilename != "" && oldFilename != filename {
+			resp, err := client.Execute(&morpheus.Request{
+				Method:      "DELETE",
+				Path:        fmt.Sprintf("%s/%s/files", morpheus.VirtualImagesPath, id),
+				QueryParams: map[string]string{"filename": oldFilename},
+				Result:      &morpheus.DeleteResult{},
+			})
+			if err != nil && (resp == nil || resp.StatusCode != 404) {
+				log.Printf("API FAILURE: %s - %s", resp, err)
+				return diag.FromErr(err)
+			}
+		}
+		if err := uploadVirtualImageContent(ctx, client, toInt64(id), d, filename); err != nil {
+			return diag.FromErr(err)
+		}
+		d.Set("filename", filename)
+	}
+
+	return resourceVirtualImageRead(ctx, d, meta)
+}
+
+func resourceVirtualImageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*morpheus.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	req := &morpheus.Request{}
+	resp, err := client.DeleteVirtualImage(toInt64(id), req)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("API 404: %s - %s", resp, err)
+			return nil
+		} else {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("API RESPONSE: %s", resp)
+	d.SetId("")
+	return diags
+}
+
+func virtualImagePayload(d *schema.ResourceData) map[string]interface{} {
+	virtualImage := make(map[string]interface{})
+
+	virtualImage["name"] = d.Get("name").(string)
+	virtualImage["imageType"] = d.Get("image_type").(string)
+	if v, ok := d.GetOk("os_type"); ok {
+		virtualImage["osType"] = map[string]interface{}{
+			"code": v.(string),
+		}
+	}
+	virtualImage["minRam"] = int64(d.Get("minimum_memory").(int)) * 1024 * 1024
+	virtualImage["minDisk"] = int64(d.Get("minimum_disk").(int)) * 1024 * 1024 * 1024
+	virtualImage["isCloudInit"] = d.Get("is_cloud_init").(bool)
+	virtualImage["isSysprep"] = d.Get("is_sysprep").(bool)
+	virtualImage["installAgent"] = d.Get("install_agent").(bool)
+	virtualImage["visibility"] = d.Get("visibility").(string)
+	if v, ok := d.GetOk("storage_provider_id"); ok {
+		virtualImage["storageProvider"] = map[string]interface{}{
+			"id": v.(int),
+		}
+	}
+
+	return virtualImage
+}
+
+// virtualImageFilename returns the configured filename or the base
+// name of the source file or url when it is not set
+func virtualImageFilename(d *schema.ResourceData) string {
+	if raw := d.GetRawConfig().GetAttr("filename"); !raw.IsNull() {
+		return raw.AsString()
+	}
+	if v, ok := d.GetOk("source"); ok {
+		return filepath.Base(v.(string))
+	}
+	sourceUrl := d.Get("source_url").(string)
+	if u, err := url.Parse(sourceUrl); err == nil && u.Path != "" {
+		return filepath.Base(u.Path)
+	}
+	return filepath.Base(sourceUrl)
+}
+
+// uploadVirtualImageContent uploads the source file or instructs the
+// appliance to download the source url into the virtual image
+func uploadVirtualImageContent(ctx context.Context, client *morpheus.Client, id int64, d *schema.ResourceData, filename string) error {
+	path := fmt.Sprintf("%s/%d/upload", morpheus.VirtualImagesPath, id)
+
+	if v, ok := d.GetOk("source_url"); ok {
+		resp, err := client.Execute(&morpheus.Request{
+			Method: "POST",
+			Path:   path,
+			QueryParams: map[string]string{
+				"url":      v.(string),
+				"filename": filename,
+			},
+			Result: &morpheus.StandardResult{},
+		})
+		if err != nil {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return err
+		}
+		log.Printf("API RESPONSE: %s", resp)
+		return nil
+	}
+
+	return streamVirtualImageFile(ctx, client, path, d.Get("source").(string), filename)
+}
+
+// streamVirtualImageFile sends the file as a multipart upload without
+// reading it into memory, the sdk buffers multipart files entirely
+// which is not viable for disk images that are several gigabytes
+func streamVirtualImageFile(ctx context.Context, client *morpheus.Client, path string, source string, filename string) error {
+	file, err := os.Open(source)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	if !client.IsLoggedIn() {
+		if resp, err := client.Login(); err != nil {
+			log.Printf("API FAILURE: %s - %s", resp, err)
+			return err
+		}
+	}
+
+	body, writer := io.Pipe()
+	multipartWriter := multipart.NewWriter(writer)
+	go func() {
+		part, err := multipartWriter.CreateFormFile("file", filename)
+		if err != nil {
+			writer.CloseWithError(err)
+			return
+		}
+		progress := &uploadProgressReader{
+			reader:   file,
+			name:     filename,
+			total:    info.Size(),
+			reported: -1,
+		}
+		if _, err := io.Copy(part, progress); err != nil {
+			writer.CloseWithError(err)
+			return
+		}
+		writer.CloseWithError(multipartWriter.Close())
+	}()
+
+	uploadUrl := fmt.Sprintf("%s%s?filename=%s", client.Url, path, url.QueryEscape(filename))
+	req, err := http.NewRequestWithContext(ctx, "POST", uploadUrl, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", multipartWriter.FormDataContentType())
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "Bearer "+client.AccessToken)
+
+	// The sdk ignores certificate errors so the upload matches it
+	httpClient := &http.Client{
+		Transport: &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		},
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	log.Printf("API RESPONSE: %s", respBody)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		var result morpheus.StandardResult
+		if err := json.Unmarshal(respBody, &result); err == nil && result.Message != "" {
+			return fmt.Errorf("failed to upload %s: %s", filename, result.Message)
+		}
+		return fmt.Errorf("failed to upload %s: API returned HTTP %d", filename, resp.StatusCode)
+	}
+	return nil
+}
+
+// uploadProgressReader logs the upload progress in 10 percent increments
+type uploadProgressReader struct {
+	reader   io.Reader
+	name     string
+	total    int64
+	read     int64
+	reported int64
+}
+
+func (r *uploadProgressReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.read += int64(n)
+	if r.total > 0 {
+		percent := r.read * 100 / r.total
+		if percent/10 > r.reported/10 || (percent == 100 && r.reported != 100) {
+			r.reported = percent
+			log.Printf("[INFO] Uploading %s: %d%% (%d/%d bytes)", r.name, percent, r.read, r.total)
+		}
+	}
+	return n, err
+}
+
+// setVirtualImageSourceChecksum computes the sha256 of the source file
+// when the checksum is not configured so content changes are planned
+// as an update that re-uploads the file. Images can be large so the file
+// is only hashed when its size or modification time differs from the
+// file the current checksum was computed from
+func setVirtualImageSourceChecksum(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.GetRawConfig().GetAttr("checksum").IsNull() {
+		return nil
+	}
+	if !d.NewValueKnown("source") {
+		d.SetNewComputed("checksum")
+		return nil
+	}
+	source := d.Get("source").(string)
+	if source == "" {
+		return nil
+	}
+	info, err := os.Stat(source)
+	if err != nil {
+		// The source is only required to upload new content, the uploaded
+		// image is kept when the file is missing where terraform runs
+		if d.Id() != "" && !d.HasChange("source") {
+			log.Printf("[WARN] unable to read source %s, keeping the uploaded content: %s", source, err)
+			return nil
+		}
+		return fmt.Errorf("unable to read source %s: %s", source, err)
+	}
+	fingerprint := fmt.Sprintf("%d:%d", info.Size(), info.ModTime().UnixNano())
+	if !d.HasChange("source") && fingerprint == d.Get("source_fingerprint").(string) {
+		return nil
+	}
+	checksum, err := fileSha256(source)
+	if err != nil {
+		return fmt.Errorf("unable to read source %s: %s", source, err)
+	}
+	// The fingerprint is only updated along with the checksum so touching
+	// the file without changing its content does not show in the plan
+	if checksum != d.Get("checksum").(string) {
+		if err := d.SetNew("checksum", checksum); err != nil {
+			return err
+		}
+		return d.SetNew("source_fingerprint", fingerprint)
+	}
+	return nil
+}
+
+func fileSha256(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+type VirtualImagePayload struct {
+	VirtualImage struct {
+		StorageProvider struct {
+			ID int64 `json:"id"`
+		} `json:"storageProvider"`
+	} `json:"virtualImage"`
+}
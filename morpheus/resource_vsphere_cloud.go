@@ -23,7 +23,12 @@ func resourceVsphereCloud() *schema.Resource {
 		ReadContext:   resourceVsphereCloudRead,
 		UpdateContext: resourceVsphereCloudUpdate,
 		DeleteContext: resourceVsphereCloudDelete,
-
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(45 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(45 * time.Minute),
+			Delete: schema.DefaultTimeout(45 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Description: "The ID of the cloud",
@@ -214,6 +219,18 @@ func resourceVsphereCloud() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 			},
+			"api_proxy_id": {
+				Description: "The id of the network proxy used to communicate with the cloud API",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"provisioning_proxy_id": {
+				Description: "The id of the network proxy used by instances provisioned into the cloud",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"wait_for_initial_sync": cloudWaitForInitialSyncSchema(),
+			"refresh_triggers":      cloudRefreshTriggersSchema(),
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -349,6 +366,7 @@ func resourceVsphereCloudCreate(ctx context.Context, d *schema.ResourceData, met
 	cloud["zoneType"] = cloudType
 
 	cloud["config"] = config
+	cloudNetworkProxyPayload(d, cloud)
 
 	payload := map[string]interface{}{
 		"zone": cloud,
@@ -363,6 +381,8 @@ func resourceVsphereCloudCreate(ctx context.Context, d *schema.ResourceData, met
 	log.Printf("API RESPONSE: %s", resp)
 	result := resp.Result.(*morpheus.CreateCloudResult)
 	cloudOutput := result.Cloud
+	// Successfully created resource, now set id
+	d.SetId(int64ToString(cloudOutput.ID))
 
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"initializing", "syncing"},
@@ -376,7 +396,7 @@ func resourceVsphereCloudCreate(ctx context.Context, d *schema.ResourceData, met
 			cloudStatus := result.Cloud
 			return result, cloudStatus.Status, nil
 		},
-		Timeout:      1 * time.Hour,
+		Timeout:      d.Timeout(schema.TimeoutCreate),
 		MinTimeout:   1 * time.Minute,
 		PollInterval: 1 * time.Minute,
 	}
@@ -387,8 +407,13 @@ func resourceVsphereCloudCreate(ctx context.Context, d *schema.ResourceData, met
 		return diag.Errorf("error creating cloud: %s", err)
 	}
 
-	// Successfully created resource, now set id
-	d.SetId(int64ToString(cloudOutput.ID))
+	if d.Get("wait_for_initial_sync").(bool) {
+		err = waitForCloudSync(ctx, client, cloudOutput.ID, "", d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.Errorf("error waiting for initial cloud sync: %s", err)
+		}
+	}
+
 	resourceVsphereCloudRead(ctx, d, meta)
 	return diags
 }
@@ -499,6 +524,7 @@ func resourceVsphereCloudRead(ctx context.Context, d *schema.ResourceData, meta
 		d.Set("guidance", cloud.GuidanceMode)
 		d.Set("costing", cloud.CostingMode)
 		d.Set("agent_install_mode", cloud.AgentMode)
+		setCloudNetworkProxies(d, resp.Body)
 		d.Set("visibility", cloud.Visibility)
 		d.Set("tenant_id", strconv.Itoa(int(cloud.AccountID)))
 		return diags
@@ -634,6 +660,7 @@ func resourceVsphereCloudUpdate(ctx context.Context, d *schema.ResourceData, met
 	cloud["zoneType"] = cloudType
 
 	cloud["config"] = config
+	cloudNetworkProxyPayload(d, cloud)
 
 	payload := map[string]interface{}{
 		"zone": cloud,
@@ -650,6 +677,13 @@ func resourceVsphereCloudUpdate(ctx context.Context, d *schema.ResourceData, met
 	cloudOutput := result.Cloud
 	// Successfully updated resource, now set id
 	d.SetId(int64ToString(cloudOutput.ID))
+
+	if d.HasChange("refresh_triggers") {
+		err = refreshCloud(ctx, client, cloudOutput.ID, d.Get("wait_for_initial_sync").(bool), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.Errorf("error refreshing cloud: %s", err)
+		}
+	}
 	return resourceVsphereCloudRead(ctx, d, meta)
 }
 
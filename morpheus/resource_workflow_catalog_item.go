@@ -3,7 +3,6 @@ package morpheus
 import (
 	"context"
 	"encoding/json"
-	"os"
 	"strings"
 
 	"log"
@@ -21,6 +20,7 @@ func resourceWorkflowCatalogItem() *schema.Resource {
 		ReadContext:   resourceWorkflowCatalogItemRead,
 		UpdateContext: resourceWorkflowCatalogItemUpdate,
 		DeleteContext: resourceWorkflowCatalogItemDelete,
+		CustomizeDiff: setLogoImageChecksums(logoAttributes, darkLogoAttributes),
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -85,6 +85,12 @@ func resourceWorkflowCatalogItem() *schema.Resource {
 					return strings.TrimSuffix(val.(string), "\n")
 				},
 			},
+			"form_id": {
+				Type:          schema.TypeInt,
+				Description:   "The ID of the form associated with the workflow catalog item, used instead of the option types",
+				Optional:      true,
+				ConflictsWith: []string{"option_type_ids"},
+			},
 			"option_type_ids": {
 				Type:        schema.TypeList,
 				Description: "The list of option type ids associated with the workflow catalog item",
@@ -119,6 +125,30 @@ func resourceWorkflowCatalogItem() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 			},
+			"logo_content_base64": {
+				Type:          schema.TypeString,
+				Description:   "The base64 encoded content of the workflow catalog item logo image, used instead of the logo_image_path so the image does not need to exist on the machine running terraform",
+				Optional:      true,
+				ConflictsWith: []string{"logo_image_path"},
+				RequiredWith:  []string{"logo_image_name"},
+			},
+			"logo_checksum": {
+				Type:        schema.TypeString,
+				Description: "The SHA256 checksum of the workflow catalog item logo image, the logo is uploaded again when the content of the image changes",
+				Computed:    true,
+			},
+			"dark_logo_content_base64": {
+				Type:          schema.TypeString,
+				Description:   "The base64 encoded content of the workflow catalog item dark mode logo image, used instead of the dark_logo_image_path so the image does not need to exist on the machine running terraform",
+				Optional:      true,
+				ConflictsWith: []string{"dark_logo_image_path"},
+				RequiredWith:  []string{"dark_logo_image_name"},
+			},
+			"dark_logo_checksum": {
+				Type:        schema.TypeString,
+				Description: "The SHA256 checksum of the workflow catalog item dark mode logo image, the logo is uploaded again when the content of the image changes",
+				Computed:    true,
+			},
 			"visibility": {
 				Type:         schema.TypeString,
 				Description:  "The visibility of the workflow catalog item (public or private)",
@@ -149,6 +179,14 @@ func resourceWorkflowCatalogItemCreate(ctx context.Context, d *schema.ResourceDa
 	catalogItem["iconPath"] = "custom"
 	catalogItem["context"] = d.Get("context_type").(string)
 	catalogItem["optionTypes"] = d.Get("option_type_ids")
+	if formID, ok := d.GetOk("form_id"); ok {
+		catalogItem["formType"] = "form"
+		catalogItem["form"] = map[string]interface{}{
+			"id": formID.(int),
+		}
+	} else {
+		catalogItem["formType"] = "optionTypes"
+	}
 	catalogItem["content"] = d.Get("content").(string)
 	catalogItem["visibility"] = d.Get("visibility").(string)
 
@@ -179,41 +217,19 @@ func resourceWorkflowCatalogItemCreate(ctx context.Context, d *schema.ResourceDa
 	result := resp.Result.(*morpheus.CreateCatalogItemResult)
 	catalogItemResult := result.CatalogItem
 
-	var filePayloads []*morpheus.FilePayload
-
-	if d.Get("logo_image_path") != "" && d.Get("logo_image_name") != "" {
-		data, err := os.ReadFile(d.Get("logo_image_path").(string))
-		if err != nil {
-			return diag.FromErr(err)
-		}
-
-		filePayload := &morpheus.FilePayload{
-			ParameterName: "logo",
-			FileName:      d.Get("logo_image_name").(string),
-			FileContent:   data,
-		}
-		filePayloads = append(filePayloads, filePayload)
+	filePayloads, err := logoImagePayloads(d, logoAttributes, darkLogoAttributes)
+	if err != nil {
+		return diag.FromErr(err)
 	}
-	if d.Get("dark_logo_image_path") != "" && d.Get("dark_logo_image_name") != "" {
-		darkLogoData, err := os.ReadFile(d.Get("dark_logo_image_path").(string))
+	if len(filePayloads) > 0 {
+		response, err := client.UpdateCatalogItemLogo(catalogItemResult.ID, filePayloads, &morpheus.Request{})
 		if err != nil {
+			log.Printf("API FAILURE: %s - %s", response, err)
 			return diag.FromErr(err)
 		}
-
-		darkLogoPayload := &morpheus.FilePayload{
-			ParameterName: "darkLogo",
-			FileName:      d.Get("dark_logo_image_name").(string),
-			FileContent:   darkLogoData,
-		}
-		filePayloads = append(filePayloads, darkLogoPayload)
+		log.Printf("API RESPONSE: %s", response)
 	}
 
-	response, err := client.UpdateCatalogItemLogo(catalogItemResult.ID, filePayloads, &morpheus.Request{})
-	if err != nil {
-		log.Printf("API FAILURE: %s - %s", response, err)
-	}
-	log.Printf("API RESPONSE: %s", response)
-
 	// Successfully created resource, now set id
 	d.SetId(int64ToString(catalogItemResult.ID))
 
@@ -274,6 +290,11 @@ func resourceWorkflowCatalogItemRead(ctx context.Context, d *schema.ResourceData
 		}
 	}
 	d.Set("option_type_ids", optionTypes)
+	if catalogItem.FormType == "form" {
+		d.Set("form_id", catalogItem.Form.ID)
+	} else {
+		d.Set("form_id", 0)
+	}
 	d.Set("content", catalogItem.Content)
 	d.Set("context_type", catalogItem.Context)
 	d.Set("visibility", catalogItem.Visibility)
@@ -318,6 +339,14 @@ func resourceWorkflowCatalogItemUpdate(ctx context.Context, d *schema.ResourceDa
 	catalogItem["type"] = "workflow"
 	catalogItem["context"] = d.Get("context_type").(string)
 	catalogItem["optionTypes"] = d.Get("option_type_ids")
+	if formID, ok := d.GetOk("form_id"); ok {
+		catalogItem["formType"] = "form"
+		catalogItem["form"] = map[string]interface{}{
+			"id": formID.(int),
+		}
+	} else {
+		catalogItem["formType"] = "optionTypes"
+	}
 	catalogItem["content"] = d.Get("content").(string)
 	catalogItem["visibility"] = d.Get("visibility").(string)
 
@@ -340,40 +369,18 @@ func resourceWorkflowCatalogItemUpdate(ctx context.Context, d *schema.ResourceDa
 	result := resp.Result.(*morpheus.UpdateCatalogItemResult)
 	catalogItemResult := result.CatalogItem
 
-	var filePayloads []*morpheus.FilePayload
-
-	if d.HasChange("logo_image_path") || d.HasChange("logo_image_name") {
-		data, err := os.ReadFile(d.Get("logo_image_path").(string))
-		if err != nil {
-			return diag.FromErr(err)
-		}
-
-		filePayload := &morpheus.FilePayload{
-			ParameterName: "logo",
-			FileName:      d.Get("logo_image_name").(string),
-			FileContent:   data,
-		}
-		filePayloads = append(filePayloads, filePayload)
+	filePayloads, err := logoImagePayloads(d, logoAttributes, darkLogoAttributes)
+	if err != nil {
+		return diag.FromErr(err)
 	}
-	if d.HasChange("dark_logo_image_path") || d.HasChange("dark_logo_image_name") {
-		darkLogoData, err := os.ReadFile(d.Get("dark_logo_image_path").(string))
+	if len(filePayloads) > 0 {
+		response, err := client.UpdateCatalogItemLogo(catalogItemResult.ID, filePayloads, &morpheus.Request{})
 		if err != nil {
+			log.Printf("API FAILURE: %s - %s", response, err)
 			return diag.FromErr(err)
 		}
-
-		darkLogoPayload := &morpheus.FilePayload{
-			ParameterName: "darkLogo",
-			FileName:      d.Get("dark_logo_image_name").(string),
-			FileContent:   darkLogoData,
-		}
-		filePayloads = append(filePayloads, darkLogoPayload)
-	}
-
-	response, err := client.UpdateCatalogItemLogo(catalogItemResult.ID, filePayloads, &morpheus.Request{})
-	if err != nil {
-		log.Printf("API FAILURE: %s - %s", response, err)
+		log.Printf("API RESPONSE: %s", response)
 	}
-	log.Printf("API RESPONSE: %s", response)
 
 	// Successfully updated resource, now set id
 	// err, it should not have changed though..
@@ -0,0 +1,31 @@
+package morpheus
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceWorkflowExecution() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Morpheus workflow execution resource, the workflow is executed when the resource is created and executed again when any of the arguments change",
+		CreateContext: resourceWorkflowExecutionCreate,
+		ReadContext:   resourceJobExecutionRead,
+		DeleteContext: resourceJobExecutionDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: jobExecutionSchema("workflow_id", "The ID of the workflow to execute"),
+	}
+}
+
+func resourceWorkflowExecutionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	path := fmt.Sprintf("%s/%d/execute", morpheus.TaskSetsPath, d.Get("workflow_id").(int))
+	return executeJob(ctx, d, meta, path)
+}
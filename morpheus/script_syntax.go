@@ -0,0 +1,111 @@
+package morpheus
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// scriptSyntaxTimeout bounds the run of the local interpreter
+const scriptSyntaxTimeout = 30 * time.Second
+
+// scriptSyntaxCheckers are the commands used to parse the script content
+// without executing it, keyed by the script language. The path of the
+// temporary file holding the script replaces the {file} placeholder or
+// is appended to the arguments
+var scriptSyntaxCheckers = map[string][]string{
+	"shell":      {"bash", "-n"},
+	"python":     {"python3", "-c", "import sys\ntry:\n    compile(open(sys.argv[1]).read(), 'script_content', 'exec')\nexcept SyntaxError as e:\n    print('script_content: line %s: %s' % (e.lineno, e.msg))\n    sys.exit(1)"},
+	"ruby":       {"ruby", "-c"},
+	"powershell": {"pwsh", "-NoProfile", "-NonInteractive", "-Command", "$parseErrors = $null; [System.Management.Automation.Language.Parser]::ParseFile('{file}', [ref]$null, [ref]$parseErrors) | Out-Null; $parseErrors | ForEach-Object { $_.ToString() }; if ($parseErrors) { exit 1 }"},
+}
+
+// validateScriptTaskSyntax returns a CustomizeDiff function that parses the
+// local script content with the interpreter of the language when the
+// validate_syntax attribute is enabled. The sdk does not support plan
+// warnings from a CustomizeDiff so syntax errors fail the plan, the check
+// is skipped when the interpreter is not installed where terraform runs
+func validateScriptTaskSyntax(language string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if !d.Get("validate_syntax").(bool) || d.Get("source_type").(string) != "local" {
+			return nil
+		}
+		if !d.NewValueKnown("script_content") {
+			return nil
+		}
+		findings, err := checkScriptSyntax(ctx, language, d.Get("script_content").(string))
+		if err != nil {
+			return err
+		}
+		if findings != "" {
+			return fmt.Errorf("the script content has syntax errors:\n%s", findings)
+		}
+		return nil
+	}
+}
+
+// checkScriptSyntax runs the syntax checker of the language against the
+// script and returns its findings, the check is skipped when the
+// interpreter is not installed or does not finish in time
+func checkScriptSyntax(ctx context.Context, language string, script string) (string, error) {
+	checker := scriptSyntaxCheckers[language]
+	if strings.TrimSpace(script) == "" {
+		return "", nil
+	}
+	if _, err := exec.LookPath(checker[0]); err != nil {
+		log.Printf("[WARN] %s was not found, skipping the %s syntax check", checker[0], language)
+		return "", nil
+	}
+
+	file, err := os.CreateTemp("", "morpheus-script-*")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(file.Name())
+	if _, err := file.WriteString(script); err != nil {
+		file.Close()
+		return "", err
+	}
+	file.Close()
+
+	ctx, cancel := context.WithTimeout(ctx, scriptSyntaxTimeout)
+	defer cancel()
+
+	var args []string
+	placeholder := false
+	for _, arg := range checker[1:] {
+		if strings.Contains(arg, "{file}") {
+			placeholder = true
+		}
+		args = append(args, strings.ReplaceAll(arg, "{file}", file.Name()))
+	}
+	if !placeholder {
+		args = append(args, file.Name())
+	}
+	cmd := exec.CommandContext(ctx, checker[0], args...)
+	var output bytes.Buffer
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			log.Printf("[WARN] the %s syntax check did not finish within %s, skipping it", language, scriptSyntaxTimeout)
+			return "", nil
+		}
+		if _, ok := err.(*exec.ExitError); !ok {
+			return "", fmt.Errorf("unable to run the %s syntax check: %s", language, err)
+		}
+		findings := strings.TrimSpace(strings.ReplaceAll(output.String(), file.Name(), "script_content"))
+		if findings == "" {
+			findings = fmt.Sprintf("the %s syntax check failed: %s", language, err)
+		}
+		return findings, nil
+	}
+	return "", nil
+}
@@ -0,0 +1,72 @@
+package morpheus
+
+import (
+	"context"
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+func TestCheckScriptSyntax(t *testing.T) {
+	cases := []struct {
+		name     string
+		language string
+		script   string
+		// findings is expected in the findings, empty when the script is
+		// valid. The message after the line depends on the version of the
+		// interpreter
+		findings string
+	}{
+		{"empty script", "shell", "  \n", ""},
+		{"shell valid", "shell", "if true; then\n  echo hi\nfi\n", ""},
+		{"shell invalid", "shell", "if true; then\n  echo hi\n", "script_content: line 3: "},
+		{"python valid", "python", "def f():\n    pass\n", ""},
+		{"python invalid", "python", "x = 1\ndef f(:\n    pass\n", "script_content: line 2: "},
+		{"ruby valid", "ruby", "def f\n  1\nend\n", ""},
+		{"ruby invalid", "ruby", "def f\n  1\n", "script_content:3:"},
+		{"powershell valid", "powershell", "function f { 1 }\n", ""},
+		{"powershell invalid", "powershell", "function f { 1\n", "Missing closing '}'"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			interpreter := scriptSyntaxCheckers[tc.language][0]
+			if _, err := exec.LookPath(interpreter); err != nil && strings.TrimSpace(tc.script) != "" {
+				t.Skipf("%s is not installed", interpreter)
+			}
+			findings, err := checkScriptSyntax(context.Background(), tc.language, tc.script)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if tc.findings == "" {
+				if findings != "" {
+					t.Errorf("unexpected findings: %s", findings)
+				}
+				return
+			}
+			if !strings.Contains(findings, tc.findings) {
+				t.Errorf("findings = %q, want %q in the findings", findings, tc.findings)
+			}
+		})
+	}
+}
+
+func TestCheckScriptSyntaxSkipped(t *testing.T) {
+	scriptSyntaxCheckers["missing"] = []string{"morpheus-missing-interpreter"}
+	defer delete(scriptSyntaxCheckers, "missing")
+	findings, err := checkScriptSyntax(context.Background(), "missing", "echo hi\n")
+	if err != nil || findings != "" {
+		t.Errorf("missing interpreter: findings = %q, err = %v, want the check to be skipped", findings, err)
+	}
+
+	if _, err := exec.LookPath("bash"); err != nil {
+		t.Skip("bash is not installed")
+	}
+	// A check that does not finish is skipped instead of reporting the
+	// killed interpreter as findings
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	findings, err = checkScriptSyntax(ctx, "shell", "if true; then\n  echo hi\n")
+	if err != nil || findings != "" {
+		t.Errorf("cancelled check: findings = %q, err = %v, want the check to be skipped", findings, err)
+	}
+}
@@ -0,0 +1,230 @@
+package morpheus
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template/parse"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gopkg.in/yaml.v3"
+)
+
+// templateContentValidator checks the content of a blueprint or spec
+// template, the error includes the line of the content that is invalid
+type templateContentValidator func(content string) error
+
+// validateTemplateContent returns a CustomizeDiff function that parses the
+// content attribute during the plan with the validator of the configured
+// source type, so malformed content is reported before it is sent to the
+// appliance instead of when the blueprint or spec template is deployed
+func validateTemplateContent(attribute string, validators map[string]templateContentValidator) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if !d.NewValueKnown("source_type") || !d.NewValueKnown(attribute) {
+			return nil
+		}
+		validator, ok := validators[d.Get("source_type").(string)]
+		if !ok {
+			return nil
+		}
+		content := d.Get(attribute).(string)
+		if strings.TrimSpace(content) == "" {
+			return nil
+		}
+		if err := validator(content); err != nil {
+			return fmt.Errorf("invalid %s: %s", attribute, err)
+		}
+		return nil
+	}
+}
+
+// armTemplateContent checks that the content is an ARM template, a json
+// object with the $schema and resources properties
+func armTemplateContent(content string) error {
+	template, err := jsonTemplateContent(content)
+	if err != nil {
+		return err
+	}
+	for _, property := range []string{"$schema", "resources"} {
+		if _, ok := template[property]; !ok {
+			return fmt.Errorf("line 1: the ARM template is missing the %s property", property)
+		}
+	}
+	return nil
+}
+
+// cloudFormationJSONContent checks that the content is a CloudFormation
+// template in json with the Resources property
+func cloudFormationJSONContent(content string) error {
+	template, err := jsonTemplateContent(content)
+	if err != nil {
+		return err
+	}
+	if _, ok := template["Resources"]; !ok {
+		return fmt.Errorf("line 1: the CloudFormation template is missing the Resources property")
+	}
+	return nil
+}
+
+// cloudFormationTemplateContent checks that the content is a CloudFormation
+// template in yaml or json with the Resources property, the short form of
+// the intrinsic functions (i.e. - !Ref) is supported
+func cloudFormationTemplateContent(content string) error {
+	documents, err := yamlTemplateDocuments(content)
+	if err != nil {
+		return err
+	}
+	if len(documents) != 1 || documents[0].Kind != yaml.MappingNode {
+		return fmt.Errorf("line 1: the CloudFormation template must be a single yaml or json object")
+	}
+	if yamlMappingValue(documents[0], "Resources") == nil {
+		return fmt.Errorf("line %d: the CloudFormation template is missing the Resources property", documents[0].Line)
+	}
+	return nil
+}
+
+// kubernetesTemplateContent checks that each document of the content is a
+// kubernetes object with the apiVersion and kind fields
+func kubernetesTemplateContent(content string) error {
+	documents, err := yamlTemplateDocuments(content)
+	if err != nil {
+		return err
+	}
+	for index, document := range documents {
+		if document.Kind != yaml.MappingNode {
+			return fmt.Errorf("line %d: document %d is not a kubernetes object", document.Line, index+1)
+		}
+		for _, field := range []string{"apiVersion", "kind"} {
+			value := yamlMappingValue(document, field)
+			if value == nil || value.Value == "" {
+				return fmt.Errorf("line %d: document %d is missing the %s field", document.Line, index+1, field)
+			}
+		}
+	}
+	return nil
+}
+
+// helmTemplateContent checks the template syntax of a helm chart template,
+// the content is only valid yaml once rendered so the yaml is not parsed
+// and the functions are not checked as helm provides its own
+func helmTemplateContent(content string) error {
+	tree := parse.New("template")
+	tree.Mode = parse.SkipFuncCheck
+	if _, err := tree.Parse(content, "", "", map[string]*parse.Tree{}); err != nil {
+		return errors.New(strings.ReplaceAll(strings.TrimPrefix(err.Error(), "template: "), "template:", "line "))
+	}
+	return nil
+}
+
+// jsonTemplateContent parses the content as a json object, syntax errors
+// are reported with the line and column of the invalid character. The
+// offset of the json errors is the number of bytes read including the
+// invalid character
+func jsonTemplateContent(content string) (map[string]interface{}, error) {
+	var template map[string]interface{}
+	err := json.Unmarshal([]byte(content), &template)
+	if err == nil {
+		return template, nil
+	}
+	var syntaxError *json.SyntaxError
+	if errors.As(err, &syntaxError) {
+		line, column := contentPosition(content, syntaxError.Offset-1)
+		return nil, fmt.Errorf("line %d, column %d: %s", line, column, syntaxError)
+	}
+	var typeError *json.UnmarshalTypeError
+	if errors.As(err, &typeError) {
+		line, column := contentPosition(content, typeError.Offset-1)
+		return nil, fmt.Errorf("line %d, column %d: the template must be a json object", line, column)
+	}
+	return nil, err
+}
+
+// contentPosition returns the line and column of the byte offset
+func contentPosition(content string, offset int64) (int, int) {
+	if offset < 0 {
+		offset = 0
+	}
+	if offset > int64(len(content)) {
+		offset = int64(len(content))
+	}
+	before := content[:offset]
+	line := strings.Count(before, "\n") + 1
+	column := int(offset) - strings.LastIndex(before, "\n")
+	return line, column
+}
+
+// yamlParserProblems are the problems reported by the yaml parser, unlike
+// the yaml scanner the parser reports the line of the problem from 0
+var yamlParserProblems = map[string]bool{
+	"did not find expected ',' or ']'":       true,
+	"did not find expected ',' or '}'":       true,
+	"did not find expected '-' indicator":    true,
+	"did not find expected <document start>": true,
+	"did not find expected key":              true,
+	"did not find expected node content":     true,
+	"found duplicate %TAG directive":         true,
+	"found duplicate %YAML directive":        true,
+	"found incompatible YAML document":       true,
+	"found undefined tag handle":             true,
+}
+
+var yamlErrorLine = regexp.MustCompile(`^line (\d+): (.*)$`)
+
+// yamlTemplateError returns the yaml error with the line of the problem
+// starting from 1
+func yamlTemplateError(err error) error {
+	message := strings.TrimPrefix(err.Error(), "yaml: ")
+	line := 0
+	if match := yamlErrorLine.FindStringSubmatch(message); match != nil {
+		line, _ = strconv.Atoi(match[1])
+		message = match[2]
+	}
+	if yamlParserProblems[message] {
+		line++
+	}
+	if line == 0 {
+		return errors.New(message)
+	}
+	return fmt.Errorf("line %d: %s", line, message)
+}
+
+// yamlTemplateDocuments parses each document of the yaml content, empty
+// documents are skipped and errors are reported with the line
+func yamlTemplateDocuments(content string) ([]*yaml.Node, error) {
+	var documents []*yaml.Node
+	decoder := yaml.NewDecoder(bytes.NewBufferString(content))
+	for {
+		var document yaml.Node
+		err := decoder.Decode(&document)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, yamlTemplateError(err)
+		}
+		if document.Kind == yaml.DocumentNode && len(document.Content) > 0 {
+			node := document.Content[0]
+			if node.Kind == yaml.ScalarNode && node.Tag == "!!null" {
+				continue
+			}
+			documents = append(documents, node)
+		}
+	}
+	return documents, nil
+}
+
+// yamlMappingValue returns the value of the key in the yaml mapping
+func yamlMappingValue(node *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,212 @@
+package morpheus
+
+import "testing"
+
+func TestContentPosition(t *testing.T) {
+	cases := []struct {
+		name    string
+		content string
+		offset  int64
+		line    int
+		column  int
+	}{
+		{"start", "abc", 0, 1, 1},
+		{"first line", "abc\ndef", 2, 1, 3},
+		{"second line", "abc\ndef", 5, 2, 2},
+		{"after newline", "abc\ndef", 4, 2, 1},
+		{"past the end", "abc\ndef", 100, 2, 4},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			line, column := contentPosition(tc.content, tc.offset)
+			if line != tc.line || column != tc.column {
+				t.Errorf("contentPosition() = %d, %d, want %d, %d", line, column, tc.line, tc.column)
+			}
+		})
+	}
+}
+
+func TestTemplateContentValidators(t *testing.T) {
+	cases := []struct {
+		name      string
+		validator templateContentValidator
+		content   string
+		// err is the expected error, empty when the content is valid
+		err string
+	}{
+		{
+			name:      "arm valid",
+			validator: armTemplateContent,
+			content:   `{"$schema": "https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#", "resources": []}`,
+		},
+		{
+			name:      "arm missing schema",
+			validator: armTemplateContent,
+			content:   `{"resources": []}`,
+			err:       "line 1: the ARM template is missing the $schema property",
+		},
+		{
+			name:      "arm missing resources",
+			validator: armTemplateContent,
+			content:   `{"$schema": "https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#"}`,
+			err:       "line 1: the ARM template is missing the resources property",
+		},
+		{
+			name:      "arm syntax error",
+			validator: armTemplateContent,
+			content:   "{\n  \"$schema\": \"x\",\n  \"resources\": [\n}",
+			err:       "line 4, column 1: invalid character '}' looking for beginning of value",
+		},
+		{
+			name:      "arm not an object",
+			validator: armTemplateContent,
+			content:   "[]",
+			err:       "line 1, column 1: the template must be a json object",
+		},
+		{
+			name:      "cloudformation json valid",
+			validator: cloudFormationJSONContent,
+			content:   `{"Resources": {"Bucket": {"Type": "AWS::S3::Bucket"}}}`,
+		},
+		{
+			name:      "cloudformation json missing resources",
+			validator: cloudFormationJSONContent,
+			content:   `{"Parameters": {}}`,
+			err:       "line 1: the CloudFormation template is missing the Resources property",
+		},
+		{
+			name:      "cloudformation yaml valid with short functions",
+			validator: cloudFormationTemplateContent,
+			content:   "Resources:\n  Bucket:\n    Type: AWS::S3::Bucket\n    Properties:\n      BucketName: !Ref Name\n",
+		},
+		{
+			name:      "cloudformation yaml missing resources",
+			validator: cloudFormationTemplateContent,
+			content:   "\nParameters:\n  Name:\n    Type: String\n",
+			err:       "line 2: the CloudFormation template is missing the Resources property",
+		},
+		{
+			name:      "cloudformation yaml syntax error",
+			validator: cloudFormationTemplateContent,
+			content:   "Resources:\n  Bucket:\n    Type: [AWS::S3::Bucket\n",
+			err:       "line 3: did not find expected ',' or ']'",
+		},
+		{
+			name:      "cloudformation yaml multiple documents",
+			validator: cloudFormationTemplateContent,
+			content:   "Resources: {}\n---\nResources: {}\n",
+			err:       "line 1: the CloudFormation template must be a single yaml or json object",
+		},
+		{
+			name:      "kubernetes valid",
+			validator: kubernetesTemplateContent,
+			content:   "apiVersion: v1\nkind: Namespace\nmetadata:\n  name: demo\n---\n---\napiVersion: v1\nkind: ConfigMap\n",
+		},
+		{
+			name:      "kubernetes missing kind",
+			validator: kubernetesTemplateContent,
+			content:   "apiVersion: v1\nkind: Namespace\n---\napiVersion: v1\nmetadata:\n  name: demo\n",
+			err:       "line 4: document 2 is missing the kind field",
+		},
+		{
+			name:      "kubernetes not an object",
+			validator: kubernetesTemplateContent,
+			content:   "- apiVersion: v1\n",
+			err:       "line 1: document 1 is not a kubernetes object",
+		},
+		{
+			name:      "kubernetes syntax error",
+			validator: kubernetesTemplateContent,
+			content:   "apiVersion: v1\nkind: Namespace\n  metadata: demo\n",
+			err:       "line 3: mapping values are not allowed in this context",
+		},
+		{
+			name:      "helm valid",
+			validator: helmTemplateContent,
+			content:   "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: {{ .Release.Name | quote }}\n{{- if .Values.labels }}\n  labels: {{ toYaml .Values.labels | nindent 4 }}\n{{- end }}\n",
+		},
+		{
+			name:      "helm unclosed action",
+			validator: helmTemplateContent,
+			content:   "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: {{ .Release.Name\n",
+			err:       "line 5: unclosed action started at line 4",
+		},
+		{
+			name:      "helm missing end",
+			validator: helmTemplateContent,
+			content:   "{{ if .Values.enabled }}\nkind: ConfigMap",
+			err:       "line 2: unexpected EOF",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.validator(tc.content)
+			if tc.err == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected the error %q", tc.err)
+			}
+			if err.Error() != tc.err {
+				t.Errorf("error = %q, want %q", err, tc.err)
+			}
+		})
+	}
+}
+
+func TestYamlTemplateDocuments(t *testing.T) {
+	cases := []struct {
+		name    string
+		content string
+		lines   []int
+	}{
+		{"single document", "kind: Namespace\n", []int{1}},
+		{"empty documents are skipped", "---\n---\nkind: Namespace\n---\n", []int{3}},
+		{"multiple documents", "kind: Namespace\n---\n\nkind: ConfigMap\n", []int{1, 4}},
+		{"comments only", "# nothing here\n", nil},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			documents, err := yamlTemplateDocuments(tc.content)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if len(documents) != len(tc.lines) {
+				t.Fatalf("got %d documents, want %d", len(documents), len(tc.lines))
+			}
+			for i, document := range documents {
+				if document.Line != tc.lines[i] {
+					t.Errorf("document %d is on line %d, want %d", i+1, document.Line, tc.lines[i])
+				}
+			}
+		})
+	}
+}
+
+func TestYamlTemplateDocumentsErrors(t *testing.T) {
+	cases := []struct {
+		name    string
+		content string
+		err     string
+	}{
+		{"scanner error", "apiVersion: v1\nkind: Namespace\n  metadata: demo\n", "line 3: mapping values are not allowed in this context"},
+		{"unclosed flow sequence", "a: 1\nb: [c\n", "line 2: did not find expected ',' or ']'"},
+		{"unclosed flow mapping", "a: 1\nb: {c: 1\n", "line 2: did not find expected ',' or '}'"},
+		{"sequence in a mapping", "key: value\n- item\n", "line 2: did not find expected key"},
+		{"invalid indentation", "a:\n  - b\n c: d\n", "line 3: did not find expected key"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := yamlTemplateDocuments(tc.content)
+			if err == nil {
+				t.Fatalf("expected the error %q", tc.err)
+			}
+			if err.Error() != tc.err {
+				t.Errorf("error = %q, want %q", err, tc.err)
+			}
+		})
+	}
+}
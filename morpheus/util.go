@@ -1,10 +1,27 @@
 package morpheus
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"fmt"
+	"log"
 	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gomorpheus/morpheus-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// listPageSize is the number of objects requested per page when walking
+// a Morpheus list endpoint
+const listPageSize = 100
+
 func jsonBytesEqual(b1, b2 []byte) bool {
 	var o1 interface{}
 	if err := json.Unmarshal(b1, &o1); err != nil {
@@ -18,3 +35,397 @@ func jsonBytesEqual(b1, b2 []byte) bool {
 
 	return reflect.DeepEqual(o1, o2)
 }
+
+// listAllObjects walks every page of a Morpheus list endpoint and returns
+// the raw objects found under the given response key
+func listAllObjects(client *morpheus.Client, path string, key string, queryParams map[string]string) ([]map[string]interface{}, error) {
+	var objects []map[string]interface{}
+	offset := 0
+	for {
+		params := map[string]string{}
+		for k, v := range queryParams {
+			params[k] = v
+		}
+		params["max"] = fmt.Sprintf("%d", listPageSize)
+		params["offset"] = fmt.Sprintf("%d", offset)
+
+		resp, err := client.Execute(&morpheus.Request{
+			Method:      "GET",
+			Path:        path,
+			QueryParams: params,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		var page map[string]json.RawMessage
+		if err := json.Unmarshal(resp.Body, &page); err != nil {
+			return nil, err
+		}
+		var pageObjects []map[string]interface{}
+		if err := json.Unmarshal(page[key], &pageObjects); err != nil {
+			return nil, fmt.Errorf("unable to parse %s from %s: %s", key, path, err)
+		}
+		objects = append(objects, pageObjects...)
+
+		var meta morpheus.MetaResult
+		if page["meta"] != nil {
+			json.Unmarshal(page["meta"], &meta)
+		}
+		offset += len(pageObjects)
+		if len(pageObjects) < listPageSize || (meta.Total > 0 && int64(offset) >= meta.Total) {
+			break
+		}
+	}
+	return objects, nil
+}
+
+// objectValues returns every value found at a dot separated path within a
+// raw API object, descending into lists along the way
+func objectValues(object interface{}, path string) []interface{} {
+	if path == "" {
+		if list, ok := object.([]interface{}); ok {
+			return list
+		}
+		if object == nil {
+			return nil
+		}
+		return []interface{}{object}
+	}
+	key, rest, _ := strings.Cut(path, ".")
+	switch v := object.(type) {
+	case map[string]interface{}:
+		return objectValues(v[key], rest)
+	case []interface{}:
+		var values []interface{}
+		for _, item := range v {
+			values = append(values, objectValues(item, path)...)
+		}
+		return values
+	}
+	return nil
+}
+
+// objectString returns the first value found at the path as a string
+func objectString(object interface{}, path string) string {
+	values := objectValues(object, path)
+	if len(values) == 0 || values[0] == nil {
+		return ""
+	}
+	if f, ok := values[0].(float64); ok {
+		return fmt.Sprintf("%d", int64(f))
+	}
+	return fmt.Sprintf("%v", values[0])
+}
+
+// objectInt returns the first value found at the path as an int
+func objectInt(object interface{}, path string) int {
+	values := objectValues(object, path)
+	if len(values) == 0 {
+		return 0
+	}
+	if f, ok := values[0].(float64); ok {
+		return int(f)
+	}
+	return 0
+}
+
+// objectStrings returns every value found at the path as a list of strings
+func objectStrings(object interface{}, path string) []string {
+	var values []string
+	for _, v := range objectValues(object, path) {
+		if v != nil {
+			values = append(values, fmt.Sprintf("%v", v))
+		}
+	}
+	return values
+}
+
+// matchesListFilters reports whether a raw API object matches the filters
+// configured on a plural data source. The name_regex and labels filters
+// apply to every object while the remaining filters are matched against
+// the object paths given in filterPaths keyed by attribute name.
+func matchesListFilters(d *schema.ResourceData, object map[string]interface{}, filterPaths map[string]string) (bool, error) {
+	if nameRegex, ok := d.GetOk("name_regex"); ok {
+		r, err := regexp.Compile(nameRegex.(string))
+		if err != nil {
+			return false, err
+		}
+		if !r.MatchString(objectString(object, "name")) {
+			return false, nil
+		}
+	}
+
+	if labels, ok := d.GetOk("labels"); ok {
+		objectLabels := objectStrings(object, "labels")
+		for _, label := range labels.(*schema.Set).List() {
+			found := false
+			for _, objectLabel := range objectLabels {
+				if objectLabel == label.(string) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false, nil
+			}
+		}
+	}
+
+	return matchesScopeFilters(d, object, filterPaths), nil
+}
+
+// matchesScopeFilters reports whether a raw API object matches every
+// configured attribute in filterPaths, which maps attribute names to the
+// object paths they are compared against
+func matchesScopeFilters(d *schema.ResourceData, object map[string]interface{}, filterPaths map[string]string) bool {
+	for attribute, path := range filterPaths {
+		filter, ok := d.GetOk(attribute)
+		if !ok {
+			continue
+		}
+		expected := fmt.Sprintf("%v", filter)
+		found := false
+		for _, v := range objectValues(object, path) {
+			value := fmt.Sprintf("%v", v)
+			if f, ok := v.(float64); ok {
+				value = fmt.Sprintf("%d", int64(f))
+			}
+			if strings.EqualFold(value, expected) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// dataSourceLookup describes how a single object data source resolves its
+// object from the name, code, labels and scope attributes
+type dataSourceLookup struct {
+	// Name is the human readable name of the object used in errors
+	Name string
+	// Path is the API endpoint used to list the objects
+	Path string
+	// Key is the response key that holds the list of objects
+	Key string
+	// NamePath is the object path compared to the name, defaults to name
+	NamePath string
+	// QueryParams are additional parameters sent with the list request
+	QueryParams map[string]string
+	// Code is set when the data source supports the code selector
+	Code bool
+	// Labels is set when the data source supports the labels selector
+	Labels bool
+	// ScopePaths maps the scope attributes (cloud_id, group_id, tenant_id)
+	// to the object paths they are compared against
+	ScopePaths map[string]string
+}
+
+// hasSelector reports whether any name, code or labels selector is set
+func (lookup dataSourceLookup) hasSelector(d *schema.ResourceData) bool {
+	if d.Get("name").(string) != "" {
+		return true
+	}
+	if lookup.Code && d.Get("code").(string) != "" {
+		return true
+	}
+	if lookup.Labels && d.Get("labels").(*schema.Set).Len() > 0 {
+		return true
+	}
+	return false
+}
+
+// findDataSourceObjectID resolves the id of the single object matching the
+// selectors of a data source. An error listing the candidates is returned
+// when more than one object matches.
+func findDataSourceObjectID(client *morpheus.Client, d *schema.ResourceData, lookup dataSourceLookup) (int64, error) {
+	name := d.Get("name").(string)
+	namePath := lookup.NamePath
+	if namePath == "" {
+		namePath = "name"
+	}
+	queryParams := map[string]string{}
+	for k, v := range lookup.QueryParams {
+		queryParams[k] = v
+	}
+	if name != "" {
+		queryParams[namePath] = name
+	}
+
+	objects, err := listAllObjects(client, lookup.Path, lookup.Key, queryParams)
+	if err != nil {
+		return 0, err
+	}
+
+	var matches []map[string]interface{}
+	for _, object := range objects {
+		if name != "" && objectString(object, namePath) != name {
+			continue
+		}
+		if lookup.Code {
+			if code := d.Get("code").(string); code != "" && objectString(object, "code") != code {
+				continue
+			}
+		}
+		if lookup.Labels {
+			objectLabels := objectStrings(object, "labels")
+			missing := false
+			for _, label := range d.Get("labels").(*schema.Set).List() {
+				found := false
+				for _, objectLabel := range objectLabels {
+					if objectLabel == label.(string) {
+						found = true
+						break
+					}
+				}
+				if !found {
+					missing = true
+					break
+				}
+			}
+			if missing {
+				continue
+			}
+		}
+		if !matchesScopeFilters(d, object, lookup.ScopePaths) {
+			continue
+		}
+		matches = append(matches, object)
+	}
+
+	switch len(matches) {
+	case 0:
+		return 0, fmt.Errorf("no %s found matching the specified criteria", lookup.Name)
+	case 1:
+		return int64(objectInt(matches[0], "id")), nil
+	}
+
+	var candidates []string
+	for _, match := range matches {
+		candidate := fmt.Sprintf("id: %d, name: %s", objectInt(match, "id"), objectString(match, namePath))
+		if code := objectString(match, "code"); code != "" {
+			candidate = fmt.Sprintf("%s, code: %s", candidate, code)
+		}
+		for attribute, path := range lookup.ScopePaths {
+			if value := objectString(match, path); value != "" {
+				candidate = fmt.Sprintf("%s, %s: %s", candidate, attribute, value)
+			}
+		}
+		candidates = append(candidates, candidate)
+	}
+	sort.Strings(candidates)
+	return 0, fmt.Errorf("found %d %s matches for the specified criteria, use a more specific selector or scope:\n  %s", len(matches), lookup.Name, strings.Join(candidates, "\n  "))
+}
+
+// listDataSourceID returns a stable identifier for a plural data source
+// derived from the ids of the objects it found
+func listDataSourceID(path string, ids []int) string {
+	sorted := append([]int{}, ids...)
+	sort.Ints(sorted)
+	h := sha256.New()
+	h.Write([]byte(fmt.Sprintf("%s%v", path, sorted)))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// waitForCloudSync waits for the cloud to complete an inventory refresh
+// that is newer than lastSync and then report an ok status
+func waitForCloudSync(ctx context.Context, client *morpheus.Client, id int64, lastSync string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"initializing", "syncing", "pending"},
+		Target:  []string{"ok"},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.GetCloud(id, &morpheus.Request{})
+			if err != nil {
+				return "", "", err
+			}
+			cloud := resp.Result.(*morpheus.GetCloudResult).Cloud
+			if cloud == nil {
+				return "", "", fmt.Errorf("cloud %d not found in response data", id)
+			}
+			// the refresh has not started yet
+			if cloud.LastSync == "" || cloud.LastSync == lastSync {
+				return cloud, "pending", nil
+			}
+			return cloud, cloud.Status, nil
+		},
+		Timeout:      timeout,
+		MinTimeout:   30 * time.Second,
+		Delay:        30 * time.Second,
+		PollInterval: 30 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+// refreshCloud queues an inventory refresh of the cloud and optionally
+// waits for the refresh to complete
+func refreshCloud(ctx context.Context, client *morpheus.Client, id int64, wait bool, timeout time.Duration) error {
+	resp, err := client.GetCloud(id, &morpheus.Request{})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return err
+	}
+	var lastSync string
+	if cloud := resp.Result.(*morpheus.GetCloudResult).Cloud; cloud != nil {
+		lastSync = cloud.LastSync
+	}
+
+	// the sdk refresh call does not target the refresh endpoint
+	resp, err = client.Execute(&morpheus.Request{
+		Method: "POST",
+		Path:   fmt.Sprintf("%s/%d/refresh", morpheus.CloudsPath, id),
+		Body:   map[string]interface{}{},
+		Result: &morpheus.StandardResult{},
+	})
+	if err != nil {
+		log.Printf("API FAILURE: %s - %s", resp, err)
+		return err
+	}
+	log.Printf("API RESPONSE: %s", resp)
+
+	if !wait {
+		return nil
+	}
+	return waitForCloudSync(ctx, client, id, lastSync, timeout)
+}
+
+// cloudWaitForInitialSyncSchema returns the wait_for_initial_sync attribute
+// shared by the cloud resources
+func cloudWaitForInitialSyncSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Whether to wait for the initial inventory refresh of the cloud to complete and the cloud status to be ok before the create finishes, this also applies to refreshes forced by refresh_triggers",
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+	}
+}
+
+// cloudRefreshTriggersSchema returns the refresh_triggers attribute shared by
+// the cloud resources
+func cloudRefreshTriggersSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "A map of arbitrary values that will force a refresh of the cloud inventory when changed",
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+}
+
+// setHashedSecrets replaces the plain text values of the changed secret
+// attributes in the state with their sha256 hashes
+func setHashedSecrets(d *schema.ResourceData, attributes ...string) {
+	for _, attribute := range attributes {
+		value := d.Get(attribute).(string)
+		if value == "" || !d.HasChange(attribute) {
+			continue
+		}
+		h := sha256.New()
+		h.Write([]byte(value))
+		d.Set(attribute, hex.EncodeToString(h.Sum(nil)))
+	}
+}
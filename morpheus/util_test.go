@@ -0,0 +1,63 @@
+package morpheus
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestMatchesListFilters(t *testing.T) {
+	var cloud map[string]interface{}
+	if err := json.Unmarshal([]byte(`{
+		"id": 1,
+		"name": "vmware-east",
+		"labels": ["prod", "east"],
+		"accountId": 2,
+		"zoneType": {"code": "vmware"}
+	}`), &cloud); err != nil {
+		t.Fatal(err)
+	}
+	filterPaths := map[string]string{
+		"type_code": "zoneType.code",
+		"tenant_id": "accountId",
+	}
+
+	cases := []struct {
+		name    string
+		filters map[string]interface{}
+		match   bool
+		err     bool
+	}{
+		{"no filters", map[string]interface{}{}, true, false},
+		{"name regex matches", map[string]interface{}{"name_regex": "^vmware-"}, true, false},
+		{"name regex does not match", map[string]interface{}{"name_regex": "^aws-"}, false, false},
+		{"invalid name regex", map[string]interface{}{"name_regex": "("}, false, true},
+		{"all labels present", map[string]interface{}{"labels": []interface{}{"prod", "east"}}, true, false},
+		{"label missing", map[string]interface{}{"labels": []interface{}{"prod", "west"}}, false, false},
+		{"type code matches", map[string]interface{}{"type_code": "vmware"}, true, false},
+		{"type code does not match", map[string]interface{}{"type_code": "amazon"}, false, false},
+		{"tenant id matches a json number", map[string]interface{}{"tenant_id": 2}, true, false},
+		{"tenant id does not match", map[string]interface{}{"tenant_id": 3}, false, false},
+		{"every filter matches", map[string]interface{}{"name_regex": "east$", "labels": []interface{}{"prod"}, "type_code": "vmware", "tenant_id": 2}, true, false},
+		{"one filter does not match", map[string]interface{}{"name_regex": "east$", "labels": []interface{}{"prod"}, "type_code": "vmware", "tenant_id": 3}, false, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, dataSourceMorpheusClouds().Schema, tc.filters)
+			match, err := matchesListFilters(d, cloud, filterPaths)
+			if tc.err {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if match != tc.match {
+				t.Errorf("matchesListFilters() = %t, want %t", match, tc.match)
+			}
+		})
+	}
+}